@@ -1,7 +1,48 @@
 package activities
 
-import "go.temporal.io/sdk/client"
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/temporalio/temporal-cafe/proto"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+)
 
 type Activities struct {
-	Client client.Client
+	Client   client.Client
+	Payments PaymentGateway
+	// Loyalty is the loyalty program customers earn points under, DefaultLoyaltyProgram if nil.
+	Loyalty *proto.LoyaltyProgram
+	// Ledger archives customer ledger entries which no longer fit in the customer workflow.
+	Ledger LedgerArchive
+	// Erasures records customers who have asked to be forgotten.
+	Erasures ErasureLog
+	// ErasureKey is the secret key customers are identified by in the erasure log, see ErasureSubject.
+	ErasureKey []byte
+	// Stations configures the station queues.
+	Stations *proto.StationRouting
+}
+
+// updateEntity sends an update to an entity workflow such as a gift card or customer. Unknown
+// entities and rejected updates will not succeed on retry, so they are returned as non-retryable
+// errors of the declined type.
+func updateEntity(ctx context.Context, c client.Client, id string, declined string, update string, input interface{}, result interface{}) error {
+	handle, err := c.UpdateWorkflow(ctx, id, "", update, input)
+	if err == nil {
+		err = handle.Get(ctx, result)
+	}
+
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return temporal.NewNonRetryableApplicationError(fmt.Sprintf("not found: %s", id), declined, err)
+	}
+	var rejected *temporal.ApplicationError
+	if errors.As(err, &rejected) {
+		return temporal.NewNonRetryableApplicationError(rejected.Error(), declined, err)
+	}
+
+	return err
 }
@@ -3,27 +3,55 @@ package activities
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/temporalio/temporal-cafe/proto"
 	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
 )
 
 func (a *Activities) AddLoyaltyPoints(ctx context.Context, input *proto.AddLoyaltyPointsInput) (*proto.AddLoyaltyPointsResult, error) {
-	_, err := a.Client.SignalWithStartWorkflow(
+	// Signalling a forgotten customer would start a new account for them.
+	forgotten, err := a.customerForgotten(ctx, input.Email)
+	if err != nil {
+		return nil, err
+	}
+	if forgotten {
+		return nil, temporal.NewNonRetryableApplicationError("customer has been forgotten", CustomerForgottenError, nil)
+	}
+
+	program := a.loyaltyProgram()
+
+	now := time.Now()
+	points := LoyaltyPoints(program, input.Items, now)
+	refunded := LoyaltyPoints(program, input.Refunds, now)
+	if refunded > points {
+		refunded = points
+	}
+	points -= refunded
+
+	_, err = a.Client.SignalWithStartWorkflow(
 		ctx,
 		fmt.Sprintf("customer:%s", input.Email),
 		proto.CustomerLoyaltyPointsEarnedSignal,
 		proto.CustomerLoyaltyPointsEarned{
-			Points: input.Points,
+			Points:  points,
+			Spend:   input.Spend,
+			Program: program,
+			OrderId: input.OrderId,
 		},
 		client.StartWorkflowOptions{
 			TaskQueue: "cafe",
 		},
 		"Customer",
 		proto.CustomerInput{
-			Email: input.Email,
+			Email:   input.Email,
+			Program: program,
 		},
 	)
+	if err != nil {
+		return nil, err
+	}
 
-	return &proto.AddLoyaltyPointsResult{}, err
+	return &proto.AddLoyaltyPointsResult{Points: points}, nil
 }
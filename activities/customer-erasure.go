@@ -0,0 +1,215 @@
+package activities
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/temporalio/temporal-cafe/proto"
+	"go.temporal.io/api/common/v1"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/activity"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// CustomerForgottenError is the application error type returned when loyalty points are earned by
+// a customer who has asked to be forgotten. These are not retried.
+const CustomerForgottenError = "CustomerForgotten"
+
+// ErasureSubject identifies a customer in the erasure log without keeping their email. The email is
+// hashed with a secret key, so that it can't be found by hashing a list of known emails.
+func ErasureSubject(key []byte, email string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(email))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// ErasureLog is the audit log of customers who have asked to be forgotten.
+type ErasureLog interface {
+	Record(ctx context.Context, audit *proto.CustomerErasureAudit) error
+}
+
+// FileErasureLog keeps the erasure log in a JSON lines file.
+type FileErasureLog struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewFileErasureLog(path string) *FileErasureLog {
+	return &FileErasureLog{path: path}
+}
+
+func (l *FileErasureLog) Record(ctx context.Context, audit *proto.CustomerErasureAudit) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(l.path), 0755); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	line, err := protojson.Marshal(audit)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return err
+	}
+
+	return f.Sync()
+}
+
+// customerForgotten reports whether the customer with this email has asked to be forgotten. Their
+// erasure workflow is started before anything is erased, so every worker sees it from then on,
+// until the namespace's retention period removes it.
+func (a *Activities) customerForgotten(ctx context.Context, email string) (bool, error) {
+	id := fmt.Sprintf("customer-erasure:%s", ErasureSubject(a.ErasureKey, email))
+	_, err := a.Client.DescribeWorkflowExecution(ctx, id, "")
+
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// ForgetCustomer erases the customer's loyalty account, returning the orders it referred to.
+// Customers without an account are not found, which is not an error.
+func (a *Activities) ForgetCustomer(ctx context.Context, input *proto.ForgetCustomerInput) (*proto.ForgetCustomerResult, error) {
+	var result proto.CustomerForgetResult
+	handle, err := a.Client.UpdateWorkflow(ctx, fmt.Sprintf("customer:%s", input.Email), "", proto.CustomerForgetUpdate)
+	if err == nil {
+		err = handle.Get(ctx, &result)
+	}
+
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return &proto.ForgetCustomerResult{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.ForgetCustomerResult{Found: true, OrderIds: result.OrderIds}, nil
+}
+
+// ForgetOrder removes a customer's details from an order. Running orders are told to forget the
+// customer. The history of a closed order can't be changed, and is kept as a record of the payment
+// until the namespace's retention period removes it.
+func (a *Activities) ForgetOrder(ctx context.Context, input *proto.ForgetOrderInput) (*proto.ForgetOrderResult, error) {
+	desc, err := a.Client.DescribeWorkflowExecution(ctx, input.OrderId, "")
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return &proto.ForgetOrderResult{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	execution := desc.WorkflowExecutionInfo.Execution
+	if desc.WorkflowExecutionInfo.Status == enums.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		err = a.Client.SignalWorkflow(ctx, execution.WorkflowId, execution.RunId, proto.OrderCustomerForgottenSignal, nil)
+		if err == nil {
+			return &proto.ForgetOrderResult{Running: true}, nil
+		}
+		// The order may have closed since we looked.
+		if !errors.As(err, &notFound) {
+			return nil, err
+		}
+	}
+
+	return &proto.ForgetOrderResult{Retained: true}, nil
+}
+
+// DeleteCustomerRecords deletes the histories of every run of the customer's workflow, along with
+// their archived ledger.
+func (a *Activities) DeleteCustomerRecords(ctx context.Context, input *proto.DeleteCustomerRecordsInput) (*proto.DeleteCustomerRecordsResult, error) {
+	id := fmt.Sprintf("customer:%s", input.Email)
+	namespace := activity.GetInfo(ctx).WorkflowNamespace
+
+	var runs uint32
+	var token []byte
+	for {
+		resp, err := a.Client.ListWorkflow(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+			Namespace:     namespace,
+			Query:         fmt.Sprintf("WorkflowId = %s", quoteQueryValue(id)),
+			NextPageToken: token,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range resp.Executions {
+			if err := a.deleteWorkflowExecution(ctx, e.Execution); err != nil {
+				return nil, err
+			}
+			runs++
+		}
+		token = resp.NextPageToken
+		if len(token) == 0 {
+			break
+		}
+	}
+
+	if a.Ledger != nil {
+		if err := a.Ledger.Forget(ctx, input.Email); err != nil {
+			return nil, err
+		}
+	}
+
+	return &proto.DeleteCustomerRecordsResult{RunsDeleted: runs}, nil
+}
+
+func (a *Activities) RecordCustomerErasure(ctx context.Context, input *proto.RecordCustomerErasureInput) (*proto.RecordCustomerErasureResult, error) {
+	if a.Erasures == nil {
+		return nil, fmt.Errorf("no erasure log configured")
+	}
+
+	err := a.Erasures.Record(ctx, input.Audit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.RecordCustomerErasureResult{}, nil
+}
+
+// deleteWorkflowExecution deletes a workflow's history, terminating it first if it is still running.
+// Executions which have already gone are ignored.
+func (a *Activities) deleteWorkflowExecution(ctx context.Context, execution *common.WorkflowExecution) error {
+	_, err := a.Client.WorkflowService().DeleteWorkflowExecution(ctx, &workflowservice.DeleteWorkflowExecutionRequest{
+		Namespace:         activity.GetInfo(ctx).WorkflowNamespace,
+		WorkflowExecution: execution,
+	})
+
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return nil
+	}
+
+	return err
+}
+
+// quoteQueryValue quotes a value for use in a visibility query.
+func quoteQueryValue(v string) string {
+	v = strings.ReplaceAll(v, `\`, `\\`)
+	v = strings.ReplaceAll(v, `"`, `\"`)
+
+	return `"` + v + `"`
+}
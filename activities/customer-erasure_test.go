@@ -0,0 +1,76 @@
+package activities_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/temporalio/temporal-cafe/activities"
+	"github.com/temporalio/temporal-cafe/proto"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/mocks"
+	"go.temporal.io/sdk/temporal"
+)
+
+func TestErasureSubject(t *testing.T) {
+	key := []byte("secret")
+
+	subject := activities.ErasureSubject(key, "test@example.com")
+	assert.NotContains(t, subject, "test@example.com")
+	assert.Equal(t, subject, activities.ErasureSubject(key, "test@example.com"))
+	assert.NotEqual(t, subject, activities.ErasureSubject(key, "other@example.com"))
+	// Without the key, the subject can't be found by hashing known emails.
+	assert.NotEqual(t, subject, activities.ErasureSubject([]byte("guess"), "test@example.com"))
+}
+
+func TestAddLoyaltyPointsCustomerForgotten(t *testing.T) {
+	ctx := context.Background()
+	key := []byte("secret")
+
+	// The customer is refused before anything is sent to their workflow.
+	c := &mocks.Client{}
+	c.On("DescribeWorkflowExecution", mock.Anything, "customer-erasure:"+activities.ErasureSubject(key, "test@example.com"), "").
+		Return(&workflowservice.DescribeWorkflowExecutionResponse{}, nil)
+
+	a := &activities.Activities{Client: c, ErasureKey: key}
+	_, err := a.AddLoyaltyPoints(ctx, &proto.AddLoyaltyPointsInput{
+		Email:   "test@example.com",
+		OrderId: "order-1",
+		Items: []*proto.OrderLineItem{
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Price: 300, Count: 1},
+		},
+	})
+
+	var appErr *temporal.ApplicationError
+	if assert.True(t, errors.As(err, &appErr)) {
+		assert.Equal(t, activities.CustomerForgottenError, appErr.Type())
+		assert.True(t, appErr.NonRetryable())
+	}
+	c.AssertExpectations(t)
+}
+
+func TestAddLoyaltyPointsCustomerNotForgotten(t *testing.T) {
+	ctx := context.Background()
+	key := []byte("secret")
+
+	c := &mocks.Client{}
+	c.On("DescribeWorkflowExecution", mock.Anything, "customer-erasure:"+activities.ErasureSubject(key, "test@example.com"), "").
+		Return(nil, serviceerror.NewNotFound("workflow not found"))
+	c.On("SignalWithStartWorkflow", mock.Anything, "customer:test@example.com", proto.CustomerLoyaltyPointsEarnedSignal, mock.Anything, mock.Anything, "Customer", mock.Anything).
+		Return(&mocks.WorkflowRun{}, nil)
+
+	a := &activities.Activities{Client: c, ErasureKey: key}
+	result, err := a.AddLoyaltyPoints(ctx, &proto.AddLoyaltyPointsInput{
+		Email:   "test@example.com",
+		OrderId: "order-1",
+		Items: []*proto.OrderLineItem{
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Price: 300, Count: 1},
+		},
+	})
+	assert.NoError(t, err)
+	assert.NotZero(t, result.Points)
+	c.AssertExpectations(t)
+}
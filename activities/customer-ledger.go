@@ -0,0 +1,124 @@
+package activities
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/temporalio/temporal-cafe/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// LedgerArchive stores customer ledger entries which no longer fit in the customer workflow.
+type LedgerArchive interface {
+	// Append adds entries to the customer's archive. Entries already archived are skipped, so
+	// retries are safe.
+	Append(ctx context.Context, email string, entries []*proto.CustomerLoyaltyTransaction) error
+	// Forget deletes the customer's archive.
+	Forget(ctx context.Context, email string) error
+}
+
+// FileLedgerArchive archives each customer's ledger to a JSON lines file in a local directory.
+type FileLedgerArchive struct {
+	dir string
+	mu  sync.Mutex
+}
+
+func NewFileLedgerArchive(dir string) *FileLedgerArchive {
+	return &FileLedgerArchive{dir: dir}
+}
+
+func (a *FileLedgerArchive) path(email string) string {
+	return filepath.Join(a.dir, url.PathEscape(email)+".jsonl")
+}
+
+// last returns the position of the last entry in the customer's archive.
+func (a *FileLedgerArchive) last(email string) (uint64, error) {
+	f, err := os.Open(a.path(email))
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	var last uint64
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry proto.CustomerLoyaltyTransaction
+		if err := protojson.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return 0, err
+		}
+		if entry.Seq > last {
+			last = entry.Seq
+		}
+	}
+
+	return last, scanner.Err()
+}
+
+func (a *FileLedgerArchive) Append(ctx context.Context, email string, entries []*proto.CustomerLoyaltyTransaction) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := os.MkdirAll(a.dir, 0755); err != nil {
+		return err
+	}
+
+	last, err := a.last(email)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(a.path(email), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	for _, e := range entries {
+		if e.Seq <= last {
+			continue
+		}
+		line, err := protojson.Marshal(e)
+		if err != nil {
+			return err
+		}
+		if _, err := f.Write(append(line, '\n')); err != nil {
+			return err
+		}
+	}
+
+	return f.Sync()
+}
+
+func (a *FileLedgerArchive) Forget(ctx context.Context, email string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	err := os.Remove(a.path(email))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+func (a *Activities) ArchiveCustomerLedger(ctx context.Context, input *proto.ArchiveCustomerLedgerInput) (*proto.ArchiveCustomerLedgerResult, error) {
+	if a.Ledger == nil {
+		return nil, fmt.Errorf("no ledger archive configured")
+	}
+
+	err := a.Ledger.Append(ctx, input.Email, input.Entries)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.ArchiveCustomerLedgerResult{}, nil
+}
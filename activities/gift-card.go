@@ -0,0 +1,38 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/temporalio/temporal-cafe/proto"
+)
+
+// GiftCardDeclinedError is the application error type returned when a gift card cannot be redeemed.
+// Declines are not retried.
+const GiftCardDeclinedError = "GiftCardDeclined"
+
+func (a *Activities) RedeemGiftCard(ctx context.Context, input *proto.RedeemGiftCardInput) (*proto.RedeemGiftCardResult, error) {
+	var result proto.GiftCardRedeemResult
+	err := updateEntity(ctx, a.Client, fmt.Sprintf("gift-card:%s", input.Number), GiftCardDeclinedError, proto.GiftCardRedeemUpdate, &proto.GiftCardRedeemInput{
+		Reference: input.Reference,
+		Amount:    input.Amount,
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.RedeemGiftCardResult{Amount: result.Amount}, nil
+}
+
+func (a *Activities) RefundGiftCard(ctx context.Context, input *proto.RefundGiftCardInput) (*proto.RefundGiftCardResult, error) {
+	var status proto.GiftCardStatus
+	err := updateEntity(ctx, a.Client, fmt.Sprintf("gift-card:%s", input.Number), GiftCardDeclinedError, proto.GiftCardRefundUpdate, &proto.GiftCardRefundInput{
+		Reference: input.Reference,
+		Amount:    input.Amount,
+	}, &status)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.RefundGiftCardResult{}, nil
+}
@@ -0,0 +1,74 @@
+package activities
+
+import (
+	"time"
+
+	"github.com/temporalio/temporal-cafe/proto"
+)
+
+// DefaultLoyaltyProgram is the loyalty program used when the worker isn't configured with one.
+// Customers earn a point for each item and their points last a year.
+func DefaultLoyaltyProgram() *proto.LoyaltyProgram {
+	return &proto.LoyaltyProgram{
+		StartingBalance:  100,
+		PointsPerItem:    1,
+		PointsExpiryDays: 365,
+		TierWindowDays:   365,
+		Tiers: []*proto.LoyaltyTier{
+			{Name: "silver", Spend: 10000, Percent: 10},
+			{Name: "gold", Spend: 25000, Percent: 25},
+		},
+	}
+}
+
+func (a *Activities) loyaltyProgram() *proto.LoyaltyProgram {
+	if a.Loyalty != nil {
+		return a.Loyalty
+	}
+
+	return DefaultLoyaltyProgram()
+}
+
+// LoyaltyPoints returns the points earned for items bought at the given time, before any tier bonus.
+func LoyaltyPoints(program *proto.LoyaltyProgram, items []*proto.OrderLineItem, at time.Time) uint32 {
+	var total uint64
+	for _, item := range items {
+		count := uint64(item.Count)
+		points := count*uint64(program.PointsPerItem) + count*uint64(item.Price)*uint64(program.PointsPerDollar)/100
+
+		for _, m := range program.Multipliers {
+			if m.Type == item.Type {
+				points = points * uint64(m.Percent) / 100
+			}
+		}
+
+		var extra uint64
+		for _, p := range program.Promotions {
+			if !promotionApplies(p, item, at) {
+				continue
+			}
+			extra += points*uint64(p.Percent)/100 + count*uint64(p.BonusPoints)
+		}
+
+		total += points + extra
+	}
+
+	return uint32(total)
+}
+
+func promotionApplies(p *proto.LoyaltyPromotion, item *proto.OrderLineItem, at time.Time) bool {
+	if p.Type != proto.ProductType_PRODUCT_TYPE_UNKNOWN && p.Type != item.Type {
+		return false
+	}
+	if p.Item != "" && p.Item != item.Name {
+		return false
+	}
+	if p.Starts != nil && at.Before(p.Starts.AsTime()) {
+		return false
+	}
+	if p.Ends != nil && !at.Before(p.Ends.AsTime()) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,178 @@
+package activities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/temporalio/temporal-cafe/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestLoyaltyPoints(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	coffee := &proto.OrderLineItem{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Price: 300, Count: 2}
+	bagel := &proto.OrderLineItem{Type: proto.ProductType_PRODUCT_TYPE_FOOD, Name: "bagel", Price: 500, Count: 1}
+
+	tests := []struct {
+		name    string
+		program *proto.LoyaltyProgram
+		items   []*proto.OrderLineItem
+		points  uint32
+	}{
+		{
+			name:    "per item",
+			program: &proto.LoyaltyProgram{PointsPerItem: 1},
+			items:   []*proto.OrderLineItem{coffee, bagel},
+			points:  3,
+		},
+		{
+			name:    "per dollar",
+			program: &proto.LoyaltyProgram{PointsPerDollar: 2},
+			items:   []*proto.OrderLineItem{coffee, bagel},
+			points:  22,
+		},
+		{
+			name: "multiplier for type",
+			program: &proto.LoyaltyProgram{
+				PointsPerDollar: 1,
+				Multipliers: []*proto.LoyaltyMultiplier{
+					{Type: proto.ProductType_PRODUCT_TYPE_FOOD, Percent: 200},
+				},
+			},
+			items:  []*proto.OrderLineItem{coffee, bagel},
+			points: 16,
+		},
+		{
+			name: "promotion for item",
+			program: &proto.LoyaltyProgram{
+				PointsPerItem: 1,
+				Promotions: []*proto.LoyaltyPromotion{
+					{Item: "coffee", BonusPoints: 5},
+				},
+			},
+			items:  []*proto.OrderLineItem{coffee, bagel},
+			points: 13,
+		},
+		{
+			name: "promotion for type",
+			program: &proto.LoyaltyProgram{
+				PointsPerDollar: 1,
+				Promotions: []*proto.LoyaltyPromotion{
+					{Type: proto.ProductType_PRODUCT_TYPE_FOOD, Percent: 50},
+				},
+			},
+			items:  []*proto.OrderLineItem{coffee, bagel},
+			points: 13,
+		},
+		{
+			name: "promotion applies to the points after multipliers",
+			program: &proto.LoyaltyProgram{
+				PointsPerDollar: 1,
+				Multipliers: []*proto.LoyaltyMultiplier{
+					{Type: proto.ProductType_PRODUCT_TYPE_FOOD, Percent: 200},
+				},
+				Promotions: []*proto.LoyaltyPromotion{
+					{Percent: 50},
+				},
+			},
+			items:  []*proto.OrderLineItem{coffee, bagel},
+			points: 24,
+		},
+		{
+			name: "promotion which has started",
+			program: &proto.LoyaltyProgram{
+				PointsPerItem: 1,
+				Promotions: []*proto.LoyaltyPromotion{
+					{BonusPoints: 1, Starts: timestamppb.New(now)},
+				},
+			},
+			items:  []*proto.OrderLineItem{bagel},
+			points: 2,
+		},
+		{
+			name: "promotion which hasn't started",
+			program: &proto.LoyaltyProgram{
+				PointsPerItem: 1,
+				Promotions: []*proto.LoyaltyPromotion{
+					{BonusPoints: 1, Starts: timestamppb.New(now.Add(time.Second))},
+				},
+			},
+			items:  []*proto.OrderLineItem{bagel},
+			points: 1,
+		},
+		{
+			name: "promotion which hasn't ended",
+			program: &proto.LoyaltyProgram{
+				PointsPerItem: 1,
+				Promotions: []*proto.LoyaltyPromotion{
+					{BonusPoints: 1, Ends: timestamppb.New(now.Add(time.Second))},
+				},
+			},
+			items:  []*proto.OrderLineItem{bagel},
+			points: 2,
+		},
+		{
+			name: "promotion which has ended",
+			program: &proto.LoyaltyProgram{
+				PointsPerItem: 1,
+				Promotions: []*proto.LoyaltyPromotion{
+					{BonusPoints: 1, Ends: timestamppb.New(now)},
+				},
+			},
+			items:  []*proto.OrderLineItem{bagel},
+			points: 1,
+		},
+		{
+			name: "promotions add up",
+			program: &proto.LoyaltyProgram{
+				PointsPerItem: 1,
+				Promotions: []*proto.LoyaltyPromotion{
+					{BonusPoints: 1},
+					{Item: "bagel", BonusPoints: 2},
+				},
+			},
+			items:  []*proto.OrderLineItem{bagel},
+			points: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.points, LoyaltyPoints(tt.program, tt.items, now))
+		})
+	}
+}
+
+func TestPromotionApplies(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	starts := timestamppb.New(now.Add(-time.Hour))
+	ends := timestamppb.New(now.Add(time.Hour))
+
+	latte := &proto.OrderLineItem{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "latte"}
+
+	tests := []struct {
+		name      string
+		promotion *proto.LoyaltyPromotion
+		at        time.Time
+		applies   bool
+	}{
+		{"everything", &proto.LoyaltyPromotion{}, now, true},
+		{"matching type", &proto.LoyaltyPromotion{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE}, now, true},
+		{"other type", &proto.LoyaltyPromotion{Type: proto.ProductType_PRODUCT_TYPE_FOOD}, now, false},
+		{"matching item", &proto.LoyaltyPromotion{Item: "latte"}, now, true},
+		{"other item", &proto.LoyaltyPromotion{Item: "mocha"}, now, false},
+		{"matching type, other item", &proto.LoyaltyPromotion{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Item: "mocha"}, now, false},
+		{"before start", &proto.LoyaltyPromotion{Starts: starts, Ends: ends}, starts.AsTime().Add(-time.Nanosecond), false},
+		{"at start", &proto.LoyaltyPromotion{Starts: starts, Ends: ends}, starts.AsTime(), true},
+		{"before end", &proto.LoyaltyPromotion{Starts: starts, Ends: ends}, ends.AsTime().Add(-time.Nanosecond), true},
+		{"at end", &proto.LoyaltyPromotion{Starts: starts, Ends: ends}, ends.AsTime(), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.applies, promotionApplies(tt.promotion, latte, tt.at))
+		})
+	}
+}
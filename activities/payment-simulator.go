@@ -0,0 +1,183 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Payment tokens with special behaviour in the simulator. Any other token is authorized.
+const (
+	// SimulatorDeclineToken is always declined.
+	SimulatorDeclineToken = "tok_decline"
+	// SimulatorTimeoutToken never gets a response from the gateway.
+	SimulatorTimeoutToken = "tok_timeout"
+	// SimulatorTransientToken fails TransientFailures times before it is authorized.
+	SimulatorTransientToken = "tok_transient"
+)
+
+type PaymentSimulatorOptions struct {
+	// Latency is added to every request.
+	Latency time.Duration
+	// TransientFailures is the number of times an authorization using SimulatorTransientToken fails before it succeeds.
+	TransientFailures int
+}
+
+type simulatedPayment struct {
+	authorized uint32
+	captured   uint32
+	refunded   uint32
+	voided     bool
+}
+
+// PaymentSimulator is an in-process PaymentGateway for development and testing.
+type PaymentSimulator struct {
+	options PaymentSimulatorOptions
+
+	mu       sync.Mutex
+	last     int
+	failures map[string]int
+	payments map[string]*simulatedPayment
+	// authcodes holds the authorization code given for each reference.
+	authcodes map[string]string
+}
+
+// NewPaymentSimulator creates a payment simulator
+func NewPaymentSimulator(options PaymentSimulatorOptions) *PaymentSimulator {
+	return &PaymentSimulator{
+		options:   options,
+		failures:  make(map[string]int),
+		payments:  make(map[string]*simulatedPayment),
+		authcodes: make(map[string]string),
+	}
+}
+
+func (s *PaymentSimulator) wait(ctx context.Context) error {
+	if s.options.Latency == 0 {
+		return nil
+	}
+
+	select {
+	case <-time.After(s.options.Latency):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *PaymentSimulator) Authorize(ctx context.Context, reference string, token string, amount uint32) (string, error) {
+	if err := s.wait(ctx); err != nil {
+		return "", err
+	}
+
+	switch token {
+	case SimulatorDeclineToken:
+		return "", fmt.Errorf("%w: card declined", ErrPaymentDeclined)
+	case SimulatorTimeoutToken:
+		<-ctx.Done()
+		return "", ctx.Err()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if authcode, ok := s.authcodes[reference]; ok && reference != "" {
+		return authcode, nil
+	}
+
+	if token == SimulatorTransientToken {
+		key := fmt.Sprintf("%s:%d", token, amount)
+		if s.failures[key] < s.options.TransientFailures {
+			s.failures[key]++
+			return "", fmt.Errorf("payment gateway unavailable")
+		}
+		delete(s.failures, key)
+	}
+
+	s.last++
+	authcode := fmt.Sprintf("sim-%06d", s.last)
+	s.payments[authcode] = &simulatedPayment{authorized: amount}
+	if reference != "" {
+		s.authcodes[reference] = authcode
+	}
+
+	return authcode, nil
+}
+
+// payment returns the payment for an authorization code. Payments authorized before the simulator
+// was restarted are unknown, so nil is returned and the caller should let the request through.
+func (s *PaymentSimulator) payment(authcode string) *simulatedPayment {
+	return s.payments[authcode]
+}
+
+func (s *PaymentSimulator) Capture(ctx context.Context, authcode string, amount uint32) error {
+	if err := s.wait(ctx); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.payment(authcode)
+	if p == nil {
+		return nil
+	}
+	if p.voided {
+		return fmt.Errorf("authorization has been voided: %s", authcode)
+	}
+	// Captures are idempotent so that retries are safe.
+	if p.captured > 0 {
+		return nil
+	}
+	if amount > p.authorized {
+		return fmt.Errorf("capture of %d exceeds authorization of %d for %s", amount, p.authorized, authcode)
+	}
+	p.captured = amount
+
+	return nil
+}
+
+func (s *PaymentSimulator) Void(ctx context.Context, authcode string) error {
+	if err := s.wait(ctx); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.payment(authcode)
+	if p == nil {
+		return nil
+	}
+	if p.captured > 0 {
+		return fmt.Errorf("authorization has been captured: %s", authcode)
+	}
+	p.voided = true
+
+	return nil
+}
+
+func (s *PaymentSimulator) Refund(ctx context.Context, authcode string, amount uint32) error {
+	if err := s.wait(ctx); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.payment(authcode)
+	if p == nil {
+		return nil
+	}
+	// Refunds are idempotent so that retries are safe.
+	if p.refunded > 0 {
+		return nil
+	}
+	if amount > p.captured {
+		return fmt.Errorf("refund of %d exceeds capture of %d for %s", amount, p.captured, authcode)
+	}
+	p.refunded = amount
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package activities_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/temporalio/temporal-cafe/activities"
+)
+
+func TestPaymentSimulatorAuthorizeRetried(t *testing.T) {
+	s := activities.NewPaymentSimulator(activities.PaymentSimulatorOptions{})
+	ctx := context.Background()
+
+	first, err := s.Authorize(ctx, "order/1/1", "x", 300)
+	assert.NoError(t, err)
+
+	// A retry gets the original authorization rather than a second hold.
+	retried, err := s.Authorize(ctx, "order/1/1", "x", 300)
+	assert.NoError(t, err)
+	assert.Equal(t, first, retried)
+
+	other, err := s.Authorize(ctx, "order/1/2", "x", 300)
+	assert.NoError(t, err)
+	assert.NotEqual(t, first, other)
+
+	assert.NoError(t, s.Capture(ctx, first, 300))
+	assert.NoError(t, s.Void(ctx, other))
+}
+
+func TestPaymentSimulatorRefund(t *testing.T) {
+	s := activities.NewPaymentSimulator(activities.PaymentSimulatorOptions{})
+	ctx := context.Background()
+
+	authcode, err := s.Authorize(ctx, "order/1/1", "x", 300)
+	assert.NoError(t, err)
+	assert.NoError(t, s.Capture(ctx, authcode, 250))
+
+	assert.Error(t, s.Refund(ctx, authcode, 300))
+	assert.NoError(t, s.Refund(ctx, authcode, 250))
+	// A retried refund doesn't return the payment twice.
+	assert.NoError(t, s.Refund(ctx, authcode, 250))
+}
@@ -2,14 +2,70 @@ package activities
 
 import (
 	"context"
+	"errors"
 
 	"github.com/temporalio/temporal-cafe/proto"
+	"go.temporal.io/sdk/temporal"
 )
 
-func (a *Activities) ProcessPayment(ctx context.Context, input *proto.ProcessPaymentInput) (*proto.ProcessPaymentResult, error) {
-	return &proto.ProcessPaymentResult{Payment: &proto.Payment{Authcode: "x"}}, nil
+// PaymentDeclinedError is the application error type returned when a payment is declined.
+// Declines are not retried.
+const PaymentDeclinedError = "PaymentDeclined"
+
+// ErrPaymentDeclined is returned by a PaymentGateway when the customer's payment is declined.
+var ErrPaymentDeclined = errors.New("payment declined")
+
+// PaymentGateway takes and refunds payments. Payments are authorized first, placing a hold on the
+// customer's funds, and later either captured or voided.
+type PaymentGateway interface {
+	// Authorize holds amount on the payment method identified by token, returning an authorization code.
+	// Repeating an authorization with the same reference returns the original authorization code
+	// rather than placing another hold.
+	Authorize(ctx context.Context, reference string, token string, amount uint32) (string, error)
+	// Capture takes up to the authorized amount, releasing the remainder of the hold.
+	Capture(ctx context.Context, authcode string, amount uint32) error
+	// Void releases an authorization which has not been captured.
+	Void(ctx context.Context, authcode string) error
+	// Refund returns amount from a captured payment. A payment is only refunded once, so repeating
+	// a refund has no further effect.
+	Refund(ctx context.Context, authcode string, amount uint32) error
+}
+
+func (a *Activities) AuthorizePayment(ctx context.Context, input *proto.AuthorizePaymentInput) (*proto.AuthorizePaymentResult, error) {
+	authcode, err := a.Payments.Authorize(ctx, input.Reference, input.Token, input.Amount)
+	if errors.Is(err, ErrPaymentDeclined) {
+		return nil, temporal.NewNonRetryableApplicationError(err.Error(), PaymentDeclinedError, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.AuthorizePaymentResult{Payment: &proto.Payment{Authcode: authcode}}, nil
+}
+
+func (a *Activities) CapturePayment(ctx context.Context, input *proto.CapturePaymentInput) (*proto.CapturePaymentResult, error) {
+	err := a.Payments.Capture(ctx, input.Payment.GetAuthcode(), input.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.CapturePaymentResult{}, nil
+}
+
+func (a *Activities) VoidPayment(ctx context.Context, input *proto.VoidPaymentInput) (*proto.VoidPaymentResult, error) {
+	err := a.Payments.Void(ctx, input.Payment.GetAuthcode())
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.VoidPaymentResult{}, nil
 }
 
 func (a *Activities) ProcessPaymentRefund(ctx context.Context, input *proto.ProcessPaymentRefundInput) (*proto.ProcessPaymentRefundResult, error) {
+	err := a.Payments.Refund(ctx, input.Payment.GetAuthcode(), input.Amount)
+	if err != nil {
+		return nil, err
+	}
+
 	return &proto.ProcessPaymentRefundResult{}, nil
 }
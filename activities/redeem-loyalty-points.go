@@ -0,0 +1,39 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/temporalio/temporal-cafe/proto"
+)
+
+// LoyaltyPointsDeclinedError is the application error type returned when loyalty points cannot be redeemed.
+// Declines are not retried.
+const LoyaltyPointsDeclinedError = "LoyaltyPointsDeclined"
+
+func (a *Activities) RedeemLoyaltyPoints(ctx context.Context, input *proto.RedeemLoyaltyPointsInput) (*proto.RedeemLoyaltyPointsResult, error) {
+	var result proto.CustomerLoyaltyPointsRedeemResult
+	err := updateEntity(ctx, a.Client, fmt.Sprintf("customer:%s", input.Email), LoyaltyPointsDeclinedError, proto.CustomerLoyaltyPointsRedeemUpdate, &proto.CustomerLoyaltyPointsRedeemInput{
+		Reference: input.Reference,
+		Points:    input.Points,
+		OrderId:   input.OrderId,
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.RedeemLoyaltyPointsResult{Points: result.Points}, nil
+}
+
+func (a *Activities) ReleaseLoyaltyPoints(ctx context.Context, input *proto.ReleaseLoyaltyPointsInput) (*proto.ReleaseLoyaltyPointsResult, error) {
+	var balance proto.CustomerLoyaltyPointsBalance
+	err := updateEntity(ctx, a.Client, fmt.Sprintf("customer:%s", input.Email), LoyaltyPointsDeclinedError, proto.CustomerLoyaltyPointsReleaseUpdate, &proto.CustomerLoyaltyPointsReleaseInput{
+		Reference: input.Reference,
+		Points:    input.Points,
+	}, &balance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.ReleaseLoyaltyPointsResult{}, nil
+}
@@ -0,0 +1,70 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/temporalio/temporal-cafe/proto"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+)
+
+// stationOptions returns the configured options for a station. Unset options are left for the
+// station queue to default.
+func (a *Activities) stationOptions(station string) *proto.StationOptions {
+	for _, o := range a.Stations.GetStations() {
+		if o.Station == station {
+			return o
+		}
+	}
+
+	return &proto.StationOptions{Station: station}
+}
+
+// QueueStationOrder adds an order to its station's queue, or updates the order's place in it,
+// starting the queue if it isn't running. The station's options only take effect when its queue
+// starts.
+func (a *Activities) QueueStationOrder(ctx context.Context, input *proto.QueueStationOrderInput) (*proto.QueueStationOrderResult, error) {
+	_, err := a.Client.SignalWithStartWorkflow(
+		ctx,
+		fmt.Sprintf("station-queue:%s", input.Station),
+		proto.StationQueueOrderSignal,
+		input.Order,
+		client.StartWorkflowOptions{
+			TaskQueue: "cafe",
+		},
+		"StationQueue",
+		&proto.StationQueueInput{
+			Options: a.stationOptions(input.Station),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.QueueStationOrderResult{}, nil
+}
+
+// FindClosedStationOrders returns the station orders whose workflows are no longer running. Orders
+// which are terminated, or time out, never tell their queue they have closed.
+func (a *Activities) FindClosedStationOrders(ctx context.Context, input *proto.FindClosedStationOrdersInput) (*proto.FindClosedStationOrdersResult, error) {
+	var closed []string
+	for _, id := range input.OrderIds {
+		desc, err := a.Client.DescribeWorkflowExecution(ctx, id, "")
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			closed = append(closed, id)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if desc.WorkflowExecutionInfo.Status != enums.WORKFLOW_EXECUTION_STATUS_RUNNING {
+			closed = append(closed, id)
+		}
+	}
+
+	return &proto.FindClosedStationOrdersResult{OrderIds: closed}, nil
+}
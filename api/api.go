@@ -2,19 +2,137 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"math"
 	"net/http"
 	"strings"
 
 	"github.com/gorilla/mux"
 	"github.com/temporalio/temporal-cafe/proto"
 	"github.com/temporalio/temporal-cafe/workflows"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/sdk/client"
 )
 
 type handlers struct {
 	temporalClient client.Client
+	// stations decides which station makes each item ordered.
+	stations *proto.StationRouting
+	// erasureKey is the secret key customers are identified by when they are forgotten.
+	erasureKey []byte
+}
+
+var errItemSoldOut = errors.New("item is sold out")
+
+// orderItemsErrorStatus returns the status code for an error from resolveOrderItems.
+func orderItemsErrorStatus(err error) int {
+	if errors.Is(err, errItemSoldOut) {
+		return http.StatusConflict
+	}
+
+	return http.StatusBadRequest
+}
+
+// resolveOrderItems looks up each order item on the menu, taking its type and price from the menu
+// and routing it to the station which makes it.
+func resolveOrderItems(menu *proto.Menu, routing *proto.StationRouting, input []OrderItem) ([]*proto.OrderLineItem, error) {
+	var items []*proto.OrderLineItem
+	for _, i := range input {
+		var menuItem *proto.MenuItem
+		for _, m := range menu.Items {
+			if m.Name == i.Name {
+				menuItem = m
+				break
+			}
+		}
+		if menuItem == nil {
+			return nil, fmt.Errorf("unknown item: %s", i.Name)
+		}
+		if !menuItem.Available {
+			return nil, fmt.Errorf("%w: %s", errItemSoldOut, i.Name)
+		}
+		if i.Count == 0 || i.Count > workflows.MaxItemCount {
+			return nil, fmt.Errorf("invalid count for item: %s", i.Name)
+		}
+		station := workflows.StationFor(routing, menuItem.Type, menuItem.Category, menuItem.Name)
+		if station == "" {
+			return nil, fmt.Errorf("no station makes item: %s", i.Name)
+		}
+
+		modifiers, err := resolveModifiers(menuItem, i.Modifiers)
+		if err != nil {
+			return nil, err
+		}
+
+		price := int64(menuItem.Price)
+		for _, m := range modifiers {
+			price += int64(m.PriceDelta)
+		}
+		if price < 0 {
+			return nil, fmt.Errorf("invalid price for item: %s", i.Name)
+		}
+
+		items = append(items, &proto.OrderLineItem{
+			Type:      menuItem.Type,
+			Name:      menuItem.Name,
+			Price:     uint32(price),
+			Count:     i.Count,
+			Modifiers: modifiers,
+			Station:   station,
+		})
+	}
+
+	return items, nil
+}
+
+// resolveModifiers checks the requested modifiers against the item's modifier groups. Modifiers are
+// returned in menu order, so that the same selection always produces the same line item.
+func resolveModifiers(menuItem *proto.MenuItem, input []OrderItemModifier) ([]*proto.OrderLineItemModifier, error) {
+	selected := make(map[string]bool)
+	for _, m := range input {
+		found := false
+		for _, g := range menuItem.ModifierGroups {
+			if m.Group != "" && m.Group != g.Name {
+				continue
+			}
+			for _, gm := range g.Modifiers {
+				if gm.Name == m.Name {
+					found = true
+				}
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown modifier for item %s: %s", menuItem.Name, m.Name)
+		}
+		if selected[m.Name] {
+			return nil, fmt.Errorf("modifier chosen more than once for item %s: %s", menuItem.Name, m.Name)
+		}
+		selected[m.Name] = true
+	}
+
+	var modifiers []*proto.OrderLineItemModifier
+	for _, g := range menuItem.ModifierGroups {
+		var count uint32
+		for _, gm := range g.Modifiers {
+			if !selected[gm.Name] {
+				continue
+			}
+			count++
+			modifiers = append(modifiers, &proto.OrderLineItemModifier{
+				Group:      g.Name,
+				Name:       gm.Name,
+				PriceDelta: gm.PriceDelta,
+			})
+		}
+		if count < g.Min || count > g.Max {
+			return nil, fmt.Errorf("choose between %d and %d %s for item: %s", g.Min, g.Max, g.Name, menuItem.Name)
+		}
+	}
+
+	return modifiers, nil
 }
 
 func convertItemAPIToProto(item *OrderItem) (*proto.OrderLineItem, error) {
@@ -26,27 +144,43 @@ func convertItemAPIToProto(item *OrderItem) (*proto.OrderLineItem, error) {
 		return nil, fmt.Errorf("unknown type: %s", item.Type)
 	}
 
-	return &proto.OrderLineItem{
+	li := &proto.OrderLineItem{
 		Type:  proto.ProductType(pt),
 		Name:  item.Name,
 		Price: item.Price,
 		Count: item.Count,
-	}, nil
+	}
+	for _, m := range item.Modifiers {
+		li.Modifiers = append(li.Modifiers, &proto.OrderLineItemModifier{
+			Group:      m.Group,
+			Name:       m.Name,
+			PriceDelta: m.PriceDelta,
+		})
+	}
+
+	return li, nil
 }
 
-func (h *handlers) handleMenuFetch(w http.ResponseWriter, r *http.Request) {
-	menu := Menu{
-		Items: []MenuItem{
-			{Name: "Coffee", Type: "beverage", Price: 300},
-			{Name: "Latte", Type: "beverage", Price: 350},
-			{Name: "Milkshake", Type: "beverage", Price: 450},
-			{Name: "Bagel", Type: "food", Price: 500},
-			{Name: "Sandwich", Type: "food", Price: 600},
-		},
+func convertItemProtoToAPI(item *proto.OrderLineItem) OrderItem {
+	t := item.Type.String()
+	t = strings.TrimPrefix(t, "PRODUCT_TYPE_")
+	t = strings.ToLower(t)
+
+	oi := OrderItem{
+		Type:  t,
+		Name:  item.Name,
+		Price: item.Price,
+		Count: item.Count,
+	}
+	for _, m := range item.Modifiers {
+		oi.Modifiers = append(oi.Modifiers, OrderItemModifier{
+			Group:      m.Group,
+			Name:       m.Name,
+			PriceDelta: m.PriceDelta,
+		})
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(menu)
+	return oi
 }
 
 func (h *handlers) handleOrdersCreate(w http.ResponseWriter, r *http.Request) {
@@ -58,51 +192,225 @@ func (h *handlers) handleOrdersCreate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var items []*proto.OrderLineItem
-	for i := range input.Items {
-		item, err := convertItemAPIToProto(&input.Items[i])
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		items = append(items, item)
+	if len(input.Items) == 0 {
+		http.Error(w, "order has no items", http.StatusBadRequest)
+		return
+	}
+
+	menu, err := h.getMenu(r.Context())
+	if err != nil {
+		log.Printf("failed to fetch menu: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
 	}
 
+	items, err := resolveOrderItems(menu, h.stations, input.Items)
+	if err != nil {
+		http.Error(w, err.Error(), orderItemsErrorStatus(err))
+		return
+	}
+
+	token := input.PaymentToken
+	if token == "" {
+		// The POS does not take card details yet.
+		token = "fake"
+	}
+
+	total := orderItemsTotal(items)
+	if total > math.MaxUint32 {
+		http.Error(w, workflows.ErrOrderTotalTooLarge.Error(), http.StatusBadRequest)
+		return
+	}
+
+	tenders, err := resolveTenders(input.Tenders, input.Email, uint32(total))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	seq, err := h.nextOrderID(r.Context(), r.Header.Get("Idempotency-Key"))
+	if err != nil {
+		log.Printf("failed to allocate order id: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Order IDs are never reused. If this is a retry of a request we have already seen, the order
+	// may already have been started, or even finished, so the original order is returned instead.
+	orderInput := &proto.OrderInput{
+		Name:         input.Name,
+		Email:        input.Email,
+		PaymentToken: token,
+		GiftCard:     input.GiftCard,
+		Tenders:      tenders,
+		Items:        items,
+		MaxRemakes:   h.stations.MaxRemakes,
+	}
 	_, err = h.temporalClient.ExecuteWorkflow(
 		r.Context(),
 		client.StartWorkflowOptions{
-			TaskQueue: "cafe",
+			ID:                                       seq.Id,
+			TaskQueue:                                "cafe",
+			WorkflowIDReusePolicy:                    enums.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+			WorkflowExecutionErrorWhenAlreadyStarted: true,
 		},
 		workflows.Order,
-		&proto.OrderInput{
-			Name:         input.Name,
-			Email:        input.Email,
-			PaymentToken: "fake",
-			Items:        items,
-		},
+		orderInput,
 	)
-
+	var started *serviceerror.WorkflowExecutionAlreadyStarted
+	if seq.Duplicate && errors.As(err, &started) {
+		orderInput, err = h.getOrderInput(r.Context(), seq.Id)
+	}
 	if err != nil {
 		log.Printf("failed to start workflow: %v", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	w.WriteHeader(http.StatusCreated)
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprintf("/orders/%s", seq.Id))
+	if seq.Duplicate {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusCreated)
+	}
+	json.NewEncoder(w).Encode(orderInputToOrder(seq.Id, orderInput))
+}
+
+// orderInputToOrder returns the order as placed. Card payment tokens are not returned.
+func orderInputToOrder(id string, input *proto.OrderInput) Order {
+	order := Order{
+		ID:       id,
+		Name:     input.Name,
+		Email:    input.Email,
+		GiftCard: input.GiftCard,
+		Total:    uint32(orderItemsTotal(input.Items)),
+	}
+
+	for _, t := range input.Tenders {
+		tender := Tender{Type: tenderTypeProtoToAPI(t.Type), Token: t.Token, Amount: t.Amount}
+		if t.Type == proto.TenderType_TENDER_TYPE_CARD {
+			tender.Token = ""
+		}
+		order.Tenders = append(order.Tenders, tender)
+	}
+	for _, item := range input.Items {
+		order.Items = append(order.Items, convertItemProtoToAPI(item))
+	}
+
+	return order
+}
+
+// orderItemsTotal is computed in 64 bits so that orders too large to pay for can be refused.
+func orderItemsTotal(items []*proto.OrderLineItem) uint64 {
+	var total uint64
+	for _, item := range items {
+		total += uint64(item.Price) * uint64(item.Count)
+	}
+
+	return total
+}
+
+func tenderTypeProtoToAPI(t proto.TenderType) string {
+	s := t.String()
+	s = strings.TrimPrefix(s, "TENDER_TYPE_")
+
+	return strings.ToLower(s)
 }
 
-func Router(c client.Client) *mux.Router {
+// resolveTenders checks that the tenders pay for the whole order. A card tender without an
+// amount pays whatever is still owed, but only as the last tender. Loyalty points are taken from
+// the customer placing the order unless another account is given.
+func resolveTenders(input []Tender, email string, total uint32) ([]*proto.Tender, error) {
+	var tenders []*proto.Tender
+	var fixed uint32
+	remainder := false
+
+	for i, t := range input {
+		tt, ok := proto.TenderType_value["TENDER_TYPE_"+strings.ToUpper(t.Type)]
+		if !ok || tt == int32(proto.TenderType_TENDER_TYPE_UNKNOWN) {
+			return nil, fmt.Errorf("unknown tender type: %s", t.Type)
+		}
+
+		tender := &proto.Tender{Type: proto.TenderType(tt), Token: t.Token, Amount: t.Amount}
+		switch tender.Type {
+		case proto.TenderType_TENDER_TYPE_CARD:
+			if tender.Token == "" {
+				// The POS does not take card details yet.
+				tender.Token = "fake"
+			}
+			if tender.Amount == 0 {
+				if i != len(input)-1 {
+					return nil, fmt.Errorf("only the last card tender can pay the rest of the order")
+				}
+				remainder = true
+			}
+		case proto.TenderType_TENDER_TYPE_GIFT_CARD:
+			if tender.Token == "" {
+				return nil, fmt.Errorf("gift card tender has no card number")
+			}
+			if tender.Amount == 0 && i == len(input)-1 {
+				return nil, fmt.Errorf("gift card tender needs an amount unless followed by a card")
+			}
+		case proto.TenderType_TENDER_TYPE_LOYALTY_POINTS:
+			if tender.Token == "" {
+				tender.Token = email
+			}
+			if tender.Token == "" {
+				return nil, fmt.Errorf("loyalty points tender has no customer email")
+			}
+			if tender.Amount == 0 && i == len(input)-1 {
+				return nil, fmt.Errorf("loyalty points tender needs an amount unless followed by a card")
+			}
+			if tender.Amount%workflows.CustomerLoyaltyPointValue != 0 {
+				return nil, fmt.Errorf("loyalty points tender amount must be a multiple of %d", workflows.CustomerLoyaltyPointValue)
+			}
+		}
+
+		fixed += tender.Amount
+		tenders = append(tenders, tender)
+	}
+
+	if len(tenders) > 0 && (fixed > total || (!remainder && fixed != total)) {
+		return nil, fmt.Errorf("tenders total %d, order total is %d", fixed, total)
+	}
+
+	return tenders, nil
+}
+
+// Router returns the API's routes. Ordered items are made by the station the routing sends them to,
+// workflows.DefaultStationRouting if nil.
+func Router(c client.Client, routing *proto.StationRouting, erasureKey []byte) *mux.Router {
 	r := mux.NewRouter()
 
-	h := handlers{temporalClient: c}
+	if routing == nil {
+		routing = workflows.DefaultStationRouting()
+	}
+	h := handlers{temporalClient: c, stations: routing, erasureKey: erasureKey}
 
 	r.HandleFunc("/menu", h.handleMenuFetch).Methods("GET").Name("menu_fetch")
+	r.HandleFunc("/menu", h.handleMenuReplace).Methods("PUT").Name("menu_replace")
+	r.HandleFunc("/menu/items", h.handleMenuItemsCreate).Methods("POST").Name("menu_items_create")
+	r.HandleFunc("/menu/items/{name}", h.handleMenuItemsUpdate).Methods("PUT").Name("menu_items_update")
+	r.HandleFunc("/menu/items/{name}/availability", h.handleMenuItemAvailabilityUpdate).Methods("POST").Name("menu_item_availability_update")
+	r.HandleFunc("/menu/items/{name}", h.handleMenuItemsDelete).Methods("DELETE").Name("menu_items_delete")
 
 	r.HandleFunc("/orders", h.handleOrdersCreate).Methods("POST").Name("orders_create")
-	r.HandleFunc("/barista/orders", h.handleBaristaOrderList).Methods("GET").Name("barista_orders_list")
-	r.HandleFunc("/barista/orders/{id}/{item}/status", h.handleBaristaOrderItemStatusUpdate).Methods("POST").Name("barista_order_item_status_update")
+	r.HandleFunc("/orders/{id}", h.handleOrdersFetch).Methods("GET").Name("orders_fetch")
+	r.HandleFunc("/orders/{id}", h.handleOrdersModify).Methods("PATCH").Name("orders_modify")
+	r.HandleFunc("/orders/{id}", h.handleOrdersCancel).Methods("DELETE").Name("orders_cancel")
+	r.HandleFunc("/giftcards", h.handleGiftCardsCreate).Methods("POST").Name("gift_cards_create")
+	r.HandleFunc("/giftcards/{number}", h.handleGiftCardsFetch).Methods("GET").Name("gift_cards_fetch")
+	r.HandleFunc("/giftcards/{number}/topups", h.handleGiftCardTopUpsCreate).Methods("POST").Name("gift_card_top_ups_create")
+	r.HandleFunc("/customers/{email}", h.handleCustomersFetch).Methods("GET").Name("customers_fetch")
+	r.HandleFunc("/customers/{email}", h.handleCustomersDelete).Methods("DELETE").Name("customers_delete")
+	r.HandleFunc("/customers/{email}/ledger", h.handleCustomerLedgerFetch).Methods("GET").Name("customer_ledger_fetch")
+	r.HandleFunc("/customers/{email}/adjustments", h.handleCustomerAdjustmentsCreate).Methods("POST").Name("customer_adjustments_create")
 
-	r.HandleFunc("/kitchen/orders", h.handleKitchenOrderList).Methods("GET").Name("kitchen_orders_list")
-	r.HandleFunc("/kitchen/orders/{id}/{item}/status", h.handleKitchenOrderItemStatusUpdate).Methods("POST").Name("kitchen_order_item_status_update")
+	r.HandleFunc("/stations/{station}/queue", h.handleStationQueueFetch).Methods("GET").Name("station_queue_fetch")
+	r.HandleFunc("/stations/{station}/orders", h.handleStationOrderList).Methods("GET").Name("station_orders_list")
+	r.HandleFunc("/stations/{station}/orders/{id}/priority", h.handleStationOrderPriorityUpdate).Methods("POST").Name("station_order_priority_update")
+	r.HandleFunc("/stations/{station}/orders/{id}/{item}/status", h.handleStationOrderItemStatusUpdate).Methods("POST").Name("station_order_item_status_update")
 
 	return r
 }
@@ -0,0 +1,250 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/temporalio/temporal-cafe/activities"
+	"github.com/temporalio/temporal-cafe/proto"
+	"github.com/temporalio/temporal-cafe/workflows"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+)
+
+func customerStatusToAPI(status *proto.CustomerStatus) Customer {
+	c := Customer{
+		Email:          status.Email,
+		Points:         status.Loyalty.GetPoints(),
+		PointValue:     workflows.CustomerLoyaltyPointValue,
+		Tier:           status.Loyalty.GetTier(),
+		Spend:          status.Loyalty.GetSpend(),
+		ExpiringPoints: status.Loyalty.GetExpiringPoints(),
+		Transactions:   []LoyaltyTransaction{},
+	}
+	if status.Loyalty.GetExpires() != nil {
+		expires := status.Loyalty.Expires.AsTime()
+		c.Expires = &expires
+	}
+	for _, t := range status.Transactions {
+		c.Transactions = append(c.Transactions, loyaltyTransactionToAPI(t))
+	}
+
+	return c
+}
+
+func loyaltyTransactionToAPI(t *proto.CustomerLoyaltyTransaction) LoyaltyTransaction {
+	return LoyaltyTransaction{
+		Seq:       t.Seq,
+		Type:      loyaltyTransactionTypeProtoToAPI(t.Type),
+		Points:    t.Points,
+		Balance:   t.Balance,
+		OrderID:   t.OrderId,
+		Reference: t.Reference,
+		Reason:    t.Reason,
+		Time:      t.Time.AsTime(),
+	}
+}
+
+func loyaltyTransactionTypeProtoToAPI(t proto.CustomerLoyaltyTransactionType) string {
+	s := t.String()
+	s = strings.TrimPrefix(s, "CUSTOMER_LOYALTY_TRANSACTION_TYPE_")
+
+	return strings.ToLower(s)
+}
+
+func customerErrorStatus(err error) int {
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return http.StatusNotFound
+	}
+
+	var rejected *temporal.ApplicationError
+	if errors.As(err, &rejected) {
+		switch rejected.Type() {
+		case workflows.CustomerInvalidError:
+			return http.StatusBadRequest
+		case workflows.CustomerNoPointsError:
+			return http.StatusConflict
+		}
+	}
+
+	return http.StatusInternalServerError
+}
+
+func (h *handlers) getCustomer(ctx context.Context, email string) (*proto.CustomerStatus, error) {
+	q, err := h.temporalClient.QueryWorkflow(ctx, workflows.CustomerID(email), "", proto.CustomerStatusQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	var status proto.CustomerStatus
+	err = q.Get(&status)
+	if err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+func (h *handlers) handleCustomersFetch(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	email := vars["email"]
+
+	status, err := h.getCustomer(r.Context(), email)
+	if err != nil {
+		http.Error(w, err.Error(), customerErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(customerStatusToAPI(status))
+}
+
+func (h *handlers) handleCustomerLedgerFetch(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	email := vars["email"]
+
+	input := &proto.CustomerLedgerInput{}
+	if v := r.URL.Query().Get("before"); v != "" {
+		before, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid before: %s", v), http.StatusBadRequest)
+			return
+		}
+		input.Before = before
+	}
+	if v := r.URL.Query().Get("limit"); v != "" {
+		limit, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid limit: %s", v), http.StatusBadRequest)
+			return
+		}
+		input.Limit = uint32(limit)
+	}
+
+	q, err := h.temporalClient.QueryWorkflow(r.Context(), workflows.CustomerID(email), "", proto.CustomerLedgerQuery, input)
+	if err != nil {
+		http.Error(w, err.Error(), customerErrorStatus(err))
+		return
+	}
+
+	var ledger proto.CustomerLedger
+	err = q.Get(&ledger)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	out := LoyaltyLedger{Entries: []LoyaltyTransaction{}, Next: ledger.Next, Archived: ledger.Archived}
+	for _, t := range ledger.Entries {
+		out.Entries = append(out.Entries, loyaltyTransactionToAPI(t))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(out)
+}
+
+func (h *handlers) handleCustomerAdjustmentsCreate(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	email := vars["email"]
+
+	var input LoyaltyAdjustment
+
+	err := json.NewDecoder(r.Body).Decode(&input)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Retries with the same idempotency key only adjust the balance once.
+	handle, err := h.temporalClient.UpdateWorkflow(
+		r.Context(),
+		workflows.CustomerID(email),
+		"",
+		proto.CustomerLoyaltyPointsAdjustUpdate,
+		&proto.CustomerLoyaltyPointsAdjustInput{
+			Points:    input.Points,
+			Reason:    input.Reason,
+			Reference: r.Header.Get("Idempotency-Key"),
+		},
+	)
+	if err == nil {
+		err = handle.Get(r.Context(), nil)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), customerErrorStatus(err))
+		return
+	}
+
+	status, err := h.getCustomer(r.Context(), email)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprintf("/customers/%s", email))
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(customerStatusToAPI(status))
+}
+
+func customerErasureToAPI(audit *proto.CustomerErasureAudit) CustomerErasure {
+	return CustomerErasure{
+		Subject:        audit.GetSubject(),
+		Reason:         audit.GetReason(),
+		Time:           audit.GetTime().AsTime(),
+		CustomerFound:  audit.GetCustomerFound(),
+		OrdersScrubbed: append([]string{}, audit.GetOrdersScrubbed()...),
+		OrdersRetained: append([]string{}, audit.GetOrdersRetained()...),
+	}
+}
+
+// handleCustomersDelete forgets a customer, waiting for their data to be erased. Requests made while
+// an erasure is already running wait for that one.
+func (h *handlers) handleCustomersDelete(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	email := vars["email"]
+	reason := r.URL.Query().Get("reason")
+	if reason == "" {
+		http.Error(w, "a reason is required", http.StatusBadRequest)
+		return
+	}
+
+	subject := activities.ErasureSubject(h.erasureKey, email)
+	run, err := h.temporalClient.ExecuteWorkflow(
+		r.Context(),
+		client.StartWorkflowOptions{
+			ID:        workflows.CustomerErasureID(subject),
+			TaskQueue: "cafe",
+		},
+		workflows.CustomerErasure,
+		&proto.CustomerErasureInput{Email: email, Reason: reason, Subject: subject},
+	)
+	if err != nil {
+		log.Printf("failed to start workflow: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var result proto.CustomerErasureResult
+	err = run.Get(r.Context(), &result)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(customerErasureToAPI(result.Audit))
+}
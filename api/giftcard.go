@@ -0,0 +1,190 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/temporalio/temporal-cafe/proto"
+	"github.com/temporalio/temporal-cafe/workflows"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// giftCardNumberLength is the number of digits in a generated gift card number.
+const giftCardNumberLength = 16
+
+func newGiftCardNumber() (string, error) {
+	number := make([]byte, giftCardNumberLength)
+	for i := range number {
+		d, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", err
+		}
+		number[i] = byte('0' + d.Int64())
+	}
+
+	return string(number), nil
+}
+
+func giftCardStatusToAPI(status *proto.GiftCardStatus) GiftCard {
+	return GiftCard{
+		Number:  status.Number,
+		Balance: status.Balance,
+		Expires: status.Expires.AsTime(),
+		Expired: status.Expired,
+	}
+}
+
+func giftCardErrorStatus(err error) int {
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return http.StatusNotFound
+	}
+
+	var rejected *temporal.ApplicationError
+	if errors.As(err, &rejected) {
+		switch rejected.Type() {
+		case workflows.GiftCardInvalidError:
+			return http.StatusBadRequest
+		case workflows.GiftCardExpiredError, workflows.GiftCardNoBalanceError:
+			return http.StatusConflict
+		case workflows.GiftCardNotFoundError:
+			return http.StatusNotFound
+		}
+	}
+
+	return http.StatusInternalServerError
+}
+
+func (h *handlers) getGiftCard(ctx context.Context, number string) (*proto.GiftCardStatus, error) {
+	q, err := h.temporalClient.QueryWorkflow(ctx, workflows.GiftCardID(number), "", proto.GiftCardStatusQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	var status proto.GiftCardStatus
+	err = q.Get(&status)
+	if err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+func (h *handlers) handleGiftCardsCreate(w http.ResponseWriter, r *http.Request) {
+	var input GiftCard
+
+	err := json.NewDecoder(r.Body).Decode(&input)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if input.Balance == 0 {
+		http.Error(w, "gift card must be issued with a balance", http.StatusBadRequest)
+		return
+	}
+
+	if input.Number == "" {
+		input.Number, err = newGiftCardNumber()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	card := &proto.GiftCardInput{Number: input.Number, Balance: input.Balance}
+	if !input.Expires.IsZero() {
+		card.Expires = timestamppb.New(input.Expires)
+	}
+
+	_, err = h.temporalClient.ExecuteWorkflow(
+		r.Context(),
+		client.StartWorkflowOptions{
+			ID:                                       workflows.GiftCardID(input.Number),
+			TaskQueue:                                "cafe",
+			WorkflowIDReusePolicy:                    enums.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+			WorkflowExecutionErrorWhenAlreadyStarted: true,
+		},
+		workflows.GiftCard,
+		card,
+		nil,
+	)
+	var started *serviceerror.WorkflowExecutionAlreadyStarted
+	if errors.As(err, &started) {
+		http.Error(w, fmt.Sprintf("gift card already exists: %s", input.Number), http.StatusConflict)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	status, err := h.getGiftCard(r.Context(), input.Number)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprintf("/giftcards/%s", input.Number))
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(giftCardStatusToAPI(status))
+}
+
+func (h *handlers) handleGiftCardsFetch(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	number := vars["number"]
+
+	status, err := h.getGiftCard(r.Context(), number)
+	if err != nil {
+		http.Error(w, err.Error(), giftCardErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(giftCardStatusToAPI(status))
+}
+
+func (h *handlers) handleGiftCardTopUpsCreate(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	number := vars["number"]
+
+	var input GiftCardTopUp
+
+	err := json.NewDecoder(r.Body).Decode(&input)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	handle, err := h.temporalClient.UpdateWorkflow(
+		r.Context(),
+		workflows.GiftCardID(number),
+		"",
+		proto.GiftCardTopUpUpdate,
+		&proto.GiftCardTopUpInput{Amount: input.Amount},
+	)
+	var status proto.GiftCardStatus
+	if err == nil {
+		err = handle.Get(r.Context(), &status)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), giftCardErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(giftCardStatusToAPI(&status))
+}
@@ -0,0 +1,345 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/temporalio/temporal-cafe/proto"
+	"github.com/temporalio/temporal-cafe/workflows"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"gopkg.in/yaml.v3"
+)
+
+// menuItemFields has the fields of MenuItem without its decoding methods.
+type menuItemFields MenuItem
+
+// UnmarshalJSON decodes a menu item. Items are available unless stated otherwise.
+func (i *MenuItem) UnmarshalJSON(data []byte) error {
+	item := menuItemFields{Available: true}
+	if err := json.Unmarshal(data, &item); err != nil {
+		return err
+	}
+	*i = MenuItem(item)
+
+	return nil
+}
+
+// UnmarshalYAML decodes a menu item, such as from a menu being imported. Items are available
+// unless stated otherwise.
+func (i *MenuItem) UnmarshalYAML(value *yaml.Node) error {
+	item := menuItemFields{Available: true}
+	if err := value.Decode(&item); err != nil {
+		return err
+	}
+	*i = MenuItem(item)
+
+	return nil
+}
+
+func convertMenuItemAPIToProto(item *MenuItem) (*proto.MenuItem, error) {
+	t := strings.ToUpper(item.Type)
+	t = "PRODUCT_TYPE_" + t
+
+	pt, ok := proto.ProductType_value[t]
+	if !ok {
+		return nil, fmt.Errorf("unknown type: %s", item.Type)
+	}
+
+	mi := &proto.MenuItem{
+		Type:      proto.ProductType(pt),
+		Name:      item.Name,
+		Price:     item.Price,
+		Category:  item.Category,
+		Available: item.Available,
+	}
+	for _, g := range item.ModifierGroups {
+		group := &proto.MenuModifierGroup{Name: g.Name, Min: g.Min, Max: g.Max}
+		for _, m := range g.Modifiers {
+			group.Modifiers = append(group.Modifiers, &proto.MenuModifier{Name: m.Name, PriceDelta: m.PriceDelta})
+		}
+		mi.ModifierGroups = append(mi.ModifierGroups, group)
+	}
+
+	return mi, nil
+}
+
+func convertMenuItemProtoToAPI(item *proto.MenuItem) MenuItem {
+	t := item.Type.String()
+	t = strings.TrimPrefix(t, "PRODUCT_TYPE_")
+	t = strings.ToLower(t)
+
+	mi := MenuItem{
+		Type:      t,
+		Name:      item.Name,
+		Price:     item.Price,
+		Category:  item.Category,
+		Available: item.Available,
+	}
+	for _, g := range item.ModifierGroups {
+		group := MenuModifierGroup{Name: g.Name, Min: g.Min, Max: g.Max}
+		for _, m := range g.Modifiers {
+			group.Modifiers = append(group.Modifiers, MenuModifier{Name: m.Name, PriceDelta: m.PriceDelta})
+		}
+		mi.ModifierGroups = append(mi.ModifierGroups, group)
+	}
+
+	return mi
+}
+
+func menuProtoToAPI(menu *proto.Menu) Menu {
+	var m Menu
+	for _, item := range menu.Items {
+		m.Items = append(m.Items, convertMenuItemProtoToAPI(item))
+	}
+
+	return m
+}
+
+// startMenu starts the menu workflow. If the menu is already running this is a no-op.
+func (h *handlers) startMenu(ctx context.Context) error {
+	_, err := h.temporalClient.ExecuteWorkflow(
+		ctx,
+		client.StartWorkflowOptions{
+			ID:        workflows.MenuID,
+			TaskQueue: "cafe",
+		},
+		workflows.Menu,
+		nil,
+	)
+
+	return err
+}
+
+// getMenu fetches the current menu, starting the menu workflow if needed.
+func (h *handlers) getMenu(ctx context.Context) (*proto.Menu, error) {
+	q, err := h.temporalClient.QueryWorkflow(ctx, workflows.MenuID, "", proto.MenuQuery)
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		err = h.startMenu(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		q, err = h.temporalClient.QueryWorkflow(ctx, workflows.MenuID, "", proto.MenuQuery)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var menu proto.Menu
+	err = q.Get(&menu)
+	if err != nil {
+		return nil, err
+	}
+
+	return &menu, nil
+}
+
+// updateMenu sends an update to the menu workflow, starting it if needed.
+func (h *handlers) updateMenu(ctx context.Context, update string, input interface{}) (*proto.Menu, error) {
+	handle, err := h.temporalClient.UpdateWorkflow(ctx, workflows.MenuID, "", update, input)
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		err = h.startMenu(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		handle, err = h.temporalClient.UpdateWorkflow(ctx, workflows.MenuID, "", update, input)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var menu proto.Menu
+	err = handle.Get(ctx, &menu)
+	if err != nil {
+		return nil, err
+	}
+
+	return &menu, nil
+}
+
+func menuErrorStatus(err error) int {
+	var rejected *temporal.ApplicationError
+	if errors.As(err, &rejected) {
+		switch rejected.Type() {
+		case workflows.MenuItemInvalidError:
+			return http.StatusBadRequest
+		case workflows.MenuItemNotFoundError:
+			return http.StatusNotFound
+		case workflows.MenuItemExistsError:
+			return http.StatusConflict
+		}
+	}
+
+	return http.StatusInternalServerError
+}
+
+func (h *handlers) handleMenuFetch(w http.ResponseWriter, r *http.Request) {
+	menu, err := h.getMenu(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(menuProtoToAPI(menu))
+}
+
+func (h *handlers) handleMenuReplace(w http.ResponseWriter, r *http.Request) {
+	var input Menu
+
+	err := json.NewDecoder(r.Body).Decode(&input)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var menu proto.Menu
+	for i := range input.Items {
+		item, err := convertMenuItemAPIToProto(&input.Items[i])
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		menu.Items = append(menu.Items, item)
+	}
+
+	result, err := h.updateMenu(r.Context(), proto.MenuReplaceUpdate, &menu)
+	if err != nil {
+		http.Error(w, err.Error(), menuErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(menuProtoToAPI(result))
+}
+
+func (h *handlers) handleMenuItemsCreate(w http.ResponseWriter, r *http.Request) {
+	// New items are available unless stated otherwise.
+	var input MenuItem
+
+	err := json.NewDecoder(r.Body).Decode(&input)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	item, err := convertMenuItemAPIToProto(&input)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	_, err = h.updateMenu(r.Context(), proto.MenuItemAddUpdate, item)
+	if err != nil {
+		http.Error(w, err.Error(), menuErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprintf("/menu/items/%s", item.Name))
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(convertMenuItemProtoToAPI(item))
+}
+
+func (h *handlers) handleMenuItemsUpdate(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	name := vars["name"]
+
+	// Availability is ignored, it only changes through the availability endpoint.
+	var input MenuItem
+
+	err := json.NewDecoder(r.Body).Decode(&input)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if input.Name == "" {
+		input.Name = name
+	}
+	if input.Name != name {
+		http.Error(w, "menu items cannot be renamed", http.StatusBadRequest)
+		return
+	}
+
+	item, err := convertMenuItemAPIToProto(&input)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	menu, err := h.updateMenu(r.Context(), proto.MenuItemChangeUpdate, item)
+	if err != nil {
+		http.Error(w, err.Error(), menuErrorStatus(err))
+		return
+	}
+	for _, i := range menu.Items {
+		if i.Name == item.Name {
+			item = i
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(convertMenuItemProtoToAPI(item))
+}
+
+func (h *handlers) handleMenuItemAvailabilityUpdate(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	name := vars["name"]
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	available, err := strconv.ParseBool(strings.TrimSpace(string(body)))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid availability: %s", body), http.StatusBadRequest)
+		return
+	}
+
+	menu, err := h.updateMenu(r.Context(), proto.MenuItemAvailabilityUpdate, &proto.MenuItemAvailabilityInput{Name: name, Available: available})
+	if err != nil {
+		http.Error(w, err.Error(), menuErrorStatus(err))
+		return
+	}
+
+	for _, item := range menu.Items {
+		if item.Name == name {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(convertMenuItemProtoToAPI(item))
+			return
+		}
+	}
+
+	http.Error(w, fmt.Sprintf("menu item not found: %s", name), http.StatusNotFound)
+}
+
+func (h *handlers) handleMenuItemsDelete(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	name := vars["name"]
+
+	_, err := h.updateMenu(r.Context(), proto.MenuItemRemoveUpdate, &proto.MenuItemRemoveInput{Name: name})
+	if err != nil {
+		http.Error(w, err.Error(), menuErrorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,350 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/temporalio/temporal-cafe/proto"
+	"github.com/temporalio/temporal-cafe/workflows"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/temporal"
+)
+
+// nextOrderID allocates the next order ID from the order sequence, starting the sequence if needed.
+// Requests with an idempotency key which has already been used are given the original order ID.
+func (h *handlers) nextOrderID(ctx context.Context, key string) (*proto.OrderSequenceNextResult, error) {
+	input := &proto.OrderSequenceNextInput{IdempotencyKey: key}
+
+	handle, err := h.temporalClient.UpdateWorkflow(ctx, workflows.OrderSequenceID, "", proto.OrderSequenceNextUpdate, input)
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		_, err = h.temporalClient.ExecuteWorkflow(
+			ctx,
+			client.StartWorkflowOptions{
+				ID:        workflows.OrderSequenceID,
+				TaskQueue: "cafe",
+			},
+			workflows.OrderSequence,
+			nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		handle, err = h.temporalClient.UpdateWorkflow(ctx, workflows.OrderSequenceID, "", proto.OrderSequenceNextUpdate, input)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var result proto.OrderSequenceNextResult
+	err = handle.Get(ctx, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// getOrderInput returns the input an order was started with, from the start of its history. This
+// is available after the order has finished, unlike its status.
+func (h *handlers) getOrderInput(ctx context.Context, id string) (*proto.OrderInput, error) {
+	iter := h.temporalClient.GetWorkflowHistory(ctx, id, "", false, enums.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT)
+	if !iter.HasNext() {
+		return nil, fmt.Errorf("order has no history: %s", id)
+	}
+
+	event, err := iter.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	attrs := event.GetWorkflowExecutionStartedEventAttributes()
+	if attrs == nil {
+		return nil, fmt.Errorf("order history does not start with its input: %s", id)
+	}
+
+	var input proto.OrderInput
+	err = converter.GetDefaultDataConverter().FromPayloads(attrs.GetInput(), &input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &input, nil
+}
+
+func orderStatusToOrder(id string, status *proto.OrderStatus) OrderStatus {
+	payment := status.Payment.String()
+	payment = strings.TrimPrefix(payment, "ORDER_PAYMENT_STATUS_")
+	payment = strings.ToLower(payment)
+
+	loyalty := status.Loyalty.String()
+	loyalty = strings.TrimPrefix(loyalty, "ORDER_LOYALTY_STATUS_")
+	loyalty = strings.ToLower(loyalty)
+
+	order := OrderStatus{
+		ID:                id,
+		Name:              status.Name,
+		Open:              status.Open,
+		Payment:           payment,
+		FulfilmentExpired: status.FulfilmentExpired,
+		Loyalty:           loyalty,
+		LoyaltyPoints:     status.LoyaltyPoints,
+		LoyaltyError:      status.LoyaltyError,
+		Error:             status.Error,
+		Cancelled:         status.Cancelled,
+		Total:             status.Total,
+		Refunded:          status.Refunded,
+	}
+
+	for _, item := range status.Items {
+		order.Items = append(order.Items, convertItemProtoToAPI(item))
+	}
+	for _, item := range status.Refunds {
+		order.Refunds = append(order.Refunds, convertItemProtoToAPI(item))
+	}
+	for _, t := range status.Tenders {
+		order.Tenders = append(order.Tenders, OrderTender{
+			Type:     tenderTypeProtoToAPI(t.Type),
+			Amount:   t.Amount,
+			Refunded: t.Refunded,
+		})
+	}
+
+	for station, s := range status.Stations {
+		if order.Stations == nil {
+			order.Stations = make(map[string]StationOrder)
+		}
+		order.Stations[station] = stationStatusToOrder("", s)
+	}
+
+	return order
+}
+
+func (h *handlers) getOrderStatus(ctx context.Context, id string) (OrderStatus, error) {
+	var status proto.OrderStatus
+
+	q, err := h.temporalClient.QueryWorkflow(
+		ctx,
+		id,
+		"",
+		proto.OrderStatusQuery,
+	)
+	if err != nil {
+		return OrderStatus{}, err
+	}
+
+	err = q.Get(&status)
+	if err != nil {
+		return OrderStatus{}, err
+	}
+
+	return orderStatusToOrder(id, &status), nil
+}
+
+func (h *handlers) handleOrdersFetch(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	id := vars["id"]
+
+	order, err := h.getOrderStatus(r.Context(), id)
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(order)
+}
+
+// closedOrderStatus returns the status code for an update which found no running order: the order
+// exists but has finished, or there is no such order.
+func (h *handlers) closedOrderStatus(ctx context.Context, id string) int {
+	_, err := h.temporalClient.DescribeWorkflowExecution(ctx, id, "")
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return http.StatusNotFound
+	}
+	if err != nil {
+		return http.StatusInternalServerError
+	}
+
+	return http.StatusConflict
+}
+
+func (h *handlers) handleOrdersCancel(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	id := vars["id"]
+
+	handle, err := h.temporalClient.UpdateWorkflow(
+		r.Context(),
+		id,
+		"",
+		proto.OrderCancelUpdate,
+		&proto.OrderCancelInput{},
+	)
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			http.Error(w, err.Error(), h.closedOrderStatus(r.Context(), id))
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var status proto.OrderStatus
+	err = handle.Get(r.Context(), &status)
+	if err != nil {
+		var rejected *temporal.ApplicationError
+		if errors.As(err, &rejected) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(orderStatusToOrder(id, &status))
+}
+
+func (h *handlers) handleOrdersModify(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	id := vars["id"]
+
+	var input OrderModification
+
+	err := json.NewDecoder(r.Body).Decode(&input)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	order, err := h.getOrderStatus(r.Context(), id)
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	menu, err := h.getMenu(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	add, err := resolveOrderItems(menu, h.stations, input.Add)
+	if err != nil {
+		http.Error(w, err.Error(), orderItemsErrorStatus(err))
+		return
+	}
+	if uint64(order.Total)+orderItemsTotal(add) > math.MaxUint32 {
+		http.Error(w, workflows.ErrOrderTotalTooLarge.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Removed items are matched against the order rather than the menu, so they
+	// are refunded at the price that was paid.
+	var remove []*proto.OrderLineItem
+	for _, i := range input.Remove {
+		var ordered *OrderItem
+		for j := range order.Items {
+			if order.Items[j].Name == i.Name && sameModifiers(order.Items[j].Modifiers, i.Modifiers) {
+				ordered = &order.Items[j]
+				break
+			}
+		}
+		if ordered == nil {
+			http.Error(w, fmt.Sprintf("item not on order: %s", i.Name), http.StatusBadRequest)
+			return
+		}
+
+		item, err := convertItemAPIToProto(&OrderItem{
+			Type:      ordered.Type,
+			Name:      ordered.Name,
+			Price:     ordered.Price,
+			Count:     i.Count,
+			Modifiers: ordered.Modifiers,
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		remove = append(remove, item)
+	}
+
+	modification := proto.OrderModifyInput{Add: add, Remove: remove}
+
+	handle, err := h.temporalClient.UpdateWorkflow(
+		r.Context(),
+		id,
+		"",
+		proto.OrderModifyUpdate,
+		&modification,
+	)
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var status proto.OrderStatus
+	err = handle.Get(r.Context(), &status)
+	if err != nil {
+		var rejected *temporal.ApplicationError
+		if errors.As(err, &rejected) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(orderStatusToOrder(id, &status))
+}
+
+// sameModifiers reports whether two items have the same modifiers chosen, in any order.
+func sameModifiers(a []OrderItemModifier, b []OrderItemModifier) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	names := make(map[string]bool)
+	for _, m := range a {
+		names[m.Name] = true
+	}
+	for _, m := range b {
+		if !names[m.Name] {
+			return false
+		}
+	}
+
+	return true
+}
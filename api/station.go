@@ -0,0 +1,378 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/temporalio/temporal-cafe/proto"
+	"github.com/temporalio/temporal-cafe/workflows"
+	filterpb "go.temporal.io/api/filter/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/temporal"
+)
+
+func stationStatusToOrder(id string, status *proto.StationOrderStatus) StationOrder {
+	order := StationOrder{
+		ID:       id,
+		Station:  status.Station,
+		Name:     status.Name,
+		Open:     status.Open,
+		Admitted: status.Admitted,
+	}
+
+	for _, item := range status.Items {
+		status := item.Status.String()
+		status = strings.TrimPrefix(status, "STATION_ORDER_ITEM_STATUS_")
+		status = strings.ToLower(status)
+		order.Items = append(order.Items, StationOrderItem{
+			Name:         item.Name,
+			Status:       status,
+			Modifiers:    item.Modifiers,
+			RemakeOf:     item.RemakeOf,
+			RemakeReason: item.RemakeReason,
+			Remakes:      item.Remakes,
+		})
+	}
+
+	return order
+}
+
+// isStation reports whether products are routed to the station.
+func (h *handlers) isStation(station string) bool {
+	for _, s := range workflows.Stations(h.stations) {
+		if s == station {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getOpenStationOrderIDs lists the open orders for a station. Station order workflows carry their
+// station in their memo, so other stations' orders don't need to be queried.
+func (h *handlers) getOpenStationOrderIDs(ctx context.Context, station string) ([]string, error) {
+	var nextPageToken []byte
+	var orderIDs []string
+
+	for {
+		resp, err := h.temporalClient.ListOpenWorkflow(ctx, &workflowservice.ListOpenWorkflowExecutionsRequest{
+			Filters: &workflowservice.ListOpenWorkflowExecutionsRequest_TypeFilter{TypeFilter: &filterpb.WorkflowTypeFilter{
+				Name: "StationOrder",
+			}},
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return orderIDs, err
+		}
+
+		for _, we := range resp.Executions {
+			var s string
+			if p, ok := we.GetMemo().GetFields()[workflows.StationMemo]; ok {
+				if err := converter.GetDefaultDataConverter().FromPayload(p, &s); err != nil {
+					return orderIDs, err
+				}
+			}
+			if s == station {
+				orderIDs = append(orderIDs, we.Execution.WorkflowId)
+			}
+		}
+
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	return orderIDs, nil
+}
+
+func (h *handlers) getStationOrderStatus(ctx context.Context, id string) (StationOrder, error) {
+	var status proto.StationOrderStatus
+
+	q, err := h.temporalClient.QueryWorkflow(
+		ctx,
+		id,
+		"",
+		proto.StationOrderStatusQuery,
+	)
+	if err != nil {
+		return StationOrder{}, err
+	}
+
+	err = q.Get(&status)
+	if err != nil {
+		return StationOrder{}, err
+	}
+
+	return stationStatusToOrder(id, &status), nil
+}
+
+func stationQueueStatusToQueue(status *proto.StationQueueStatus) StationQueue {
+	queue := StationQueue{
+		Station:  status.Station,
+		Capacity: status.Capacity,
+	}
+
+	for _, p := range status.Orders {
+		queue.Orders = append(queue.Orders, StationQueueOrder{
+			ID:            p.Order.OrderId,
+			Name:          p.Order.Name,
+			Position:      p.Position,
+			Priority:      p.Order.Priority,
+			Items:         p.Order.Items,
+			Admitted:      p.Order.Admitted,
+			EstimatedWait: p.EstimatedWait.AsDuration(),
+		})
+	}
+
+	return queue
+}
+
+// getStationQueue fetches a station's queue. The queue starts with the station's first order, so
+// a station without one has an empty queue.
+func (h *handlers) getStationQueue(ctx context.Context, station string) (StationQueue, error) {
+	var status proto.StationQueueStatus
+
+	q, err := h.temporalClient.QueryWorkflow(
+		ctx,
+		workflows.StationQueueID(station),
+		"",
+		proto.StationQueueStatusQuery,
+	)
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			return StationQueue{Station: station}, nil
+		}
+		return StationQueue{}, err
+	}
+
+	err = q.Get(&status)
+	if err != nil {
+		return StationQueue{}, err
+	}
+
+	return stationQueueStatusToQueue(&status), nil
+}
+
+func (h *handlers) handleStationQueueFetch(w http.ResponseWriter, r *http.Request) {
+	station := mux.Vars(r)["station"]
+	if !h.isStation(station) {
+		http.Error(w, fmt.Sprintf("unknown station: %s", station), http.StatusNotFound)
+		return
+	}
+
+	queue, err := h.getStationQueue(r.Context(), station)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(queue)
+}
+
+func (h *handlers) handleStationOrderList(w http.ResponseWriter, r *http.Request) {
+	station := mux.Vars(r)["station"]
+	if !h.isStation(station) {
+		http.Error(w, fmt.Sprintf("unknown station: %s", station), http.StatusNotFound)
+		return
+	}
+
+	orderIDs, err := h.getOpenStationOrderIDs(r.Context(), station)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var orders []StationOrder
+	for _, id := range orderIDs {
+		order, err := h.getStationOrderStatus(r.Context(), id)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		orders = append(orders, order)
+	}
+
+	// Orders are listed in the order the station should make them. Orders the queue doesn't know
+	// about yet go last.
+	queue, err := h.getStationQueue(r.Context(), station)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	positions := make(map[string]uint32)
+	for _, o := range queue.Orders {
+		positions[o.ID] = o.Position
+	}
+	for i := range orders {
+		orders[i].Position = positions[orders[i].ID]
+	}
+	sort.SliceStable(orders, func(i, j int) bool {
+		pi, pj := orders[i].Position, orders[j].Position
+		if (pi == 0) != (pj == 0) {
+			return pi != 0
+		}
+		return pi < pj
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(orders)
+}
+
+func (h *handlers) handleStationOrderItemStatusUpdate(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	station := vars["station"]
+	id := vars["id"]
+	item := vars["item"]
+
+	line, err := strconv.ParseUint(item, 10, 32)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid line item: %s", item), http.StatusBadRequest)
+		return
+	}
+
+	s, _ := io.ReadAll(r.Body)
+	statusJSON := string(s)
+	statusJSON = "STATION_ORDER_ITEM_STATUS_" + strings.ToUpper(statusJSON)
+	status, ok := proto.StationOrderItemStatus_value[statusJSON]
+	if !ok {
+		http.Error(w, fmt.Sprintf("unknown item status: %s", statusJSON), http.StatusBadRequest)
+		return
+	}
+
+	order, err := h.getStationOrderStatus(r.Context(), id)
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if order.Station != station {
+		http.Error(w, fmt.Sprintf("order %s is not for station: %s", id, station), http.StatusNotFound)
+		return
+	}
+
+	handle, err := h.temporalClient.UpdateWorkflow(
+		r.Context(),
+		id,
+		"",
+		proto.StationOrderItemStatusUpdate,
+		&proto.StationOrderItemStatusInput{
+			Line:   uint32(line),
+			Status: proto.StationOrderItemStatus(status),
+			Reason: r.URL.Query().Get("reason"),
+		},
+	)
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var result proto.StationOrderStatus
+	err = handle.Get(r.Context(), &result)
+	if err != nil {
+		// Lines which aren't on the order are a bad request, other rejections are items which
+		// can't move to the requested status.
+		var rejected *temporal.ApplicationError
+		if errors.As(err, &rejected) {
+			if rejected.Type() == workflows.StationOrderLineInvalidError {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	order = stationStatusToOrder(id, &result)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(order)
+}
+
+// handleStationOrderPriorityUpdate lets staff move an order waiting in their station's queue, such
+// as to rush it. Orders with a higher priority are let through first.
+func (h *handlers) handleStationOrderPriorityUpdate(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	station := vars["station"]
+	id := vars["id"]
+
+	s, _ := io.ReadAll(r.Body)
+	priority, err := strconv.ParseUint(strings.TrimSpace(string(s)), 10, 32)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid priority: %s", s), http.StatusBadRequest)
+		return
+	}
+
+	order, err := h.getStationOrderStatus(r.Context(), id)
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if order.Station != station {
+		http.Error(w, fmt.Sprintf("order %s is not for station: %s", id, station), http.StatusNotFound)
+		return
+	}
+
+	handle, err := h.temporalClient.UpdateWorkflow(
+		r.Context(),
+		id,
+		"",
+		proto.StationOrderPriorityUpdate,
+		&proto.StationOrderPriorityInput{Priority: uint32(priority)},
+	)
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var result proto.StationOrderStatus
+	err = handle.Get(r.Context(), &result)
+	if err != nil {
+		var rejected *temporal.ApplicationError
+		if errors.As(err, &rejected) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	order = stationStatusToOrder(id, &result)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(order)
+}
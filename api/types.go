@@ -1,51 +1,192 @@
 package api
 
+import "time"
+
+type MenuModifier struct {
+	Name       string
+	PriceDelta int32
+}
+
+type MenuModifierGroup struct {
+	Name      string
+	Min       uint32
+	Max       uint32
+	Modifiers []MenuModifier
+}
+
 type MenuItem struct {
-	Type  string
-	Name  string
-	Price uint32
+	Type           string
+	Name           string
+	Price          uint32
+	Category       string
+	Available      bool
+	ModifierGroups []MenuModifierGroup
 }
 
 type Menu struct {
 	Items []MenuItem
 }
 
+type OrderItemModifier struct {
+	Group      string
+	Name       string
+	PriceDelta int32
+}
+
 type OrderItem struct {
-	Type  string
-	Name  string
-	Price uint32
-	Count uint32
+	Type      string
+	Name      string
+	Price     uint32
+	Count     uint32
+	Modifiers []OrderItemModifier
 }
 
 type Order struct {
-	Name  string
-	Email string
+	ID           string
+	Name         string
+	Email        string
+	PaymentToken string
+	GiftCard     string
+	Tenders      []Tender
 
 	Items []OrderItem
+	Total uint32
 }
 
-type BaristaOrderItem struct {
-	Name   string
-	Status string
+// Tender is a way of paying for part of an order. Token is the payment token for a card,
+// or the number of a gift card.
+type Tender struct {
+	Type   string
+	Token  string
+	Amount uint32
 }
 
-type BaristaOrder struct {
-	ID   string
-	Name string
-	Open bool
+type OrderTender struct {
+	Type     string
+	Amount   uint32
+	Refunded uint32
+}
+
+type StationOrderItem struct {
+	Name      string
+	Status    string
+	Modifiers []string
+	// RemakeOf is the line this item remakes, 0 if it isn't a remake.
+	RemakeOf     uint32 `json:",omitempty"`
+	RemakeReason string `json:",omitempty"`
+	Remakes      uint32 `json:",omitempty"`
+}
 
-	Items []BaristaOrderItem
+type StationOrder struct {
+	ID      string
+	Station string
+	Name    string
+	Open    bool
+	// Admitted is set once the station's queue has let the order through.
+	Admitted bool
+	// Position is the order's place in the station's queue, 0 if it hasn't joined it yet.
+	Position uint32 `json:",omitempty"`
+
+	Items []StationOrderItem
+}
+
+type StationQueueOrder struct {
+	ID       string
+	Name     string
+	Position uint32
+	Priority uint32
+	Items    uint32
+	Admitted bool
+	// EstimatedWait is how long until the station starts the order, 0 once admitted.
+	EstimatedWait time.Duration
 }
 
-type KitchenOrderItem struct {
-	Name   string
-	Status string
+type StationQueue struct {
+	Station  string
+	Capacity uint32
+
+	Orders []StationQueueOrder
 }
 
-type KitchenOrder struct {
+type OrderStatus struct {
 	ID   string
 	Name string
 	Open bool
 
-	Items []KitchenOrderItem
+	Payment           string
+	Stations          map[string]StationOrder
+	FulfilmentExpired bool
+	Loyalty           string
+	LoyaltyPoints     uint32
+	LoyaltyError      string `json:",omitempty"`
+	Error             string
+	Cancelled         bool
+
+	Items    []OrderItem
+	Total    uint32
+	Refunded uint32
+	Refunds  []OrderItem
+	Tenders  []OrderTender
+}
+
+type OrderModification struct {
+	Add    []OrderItem
+	Remove []OrderItem
+}
+
+type GiftCard struct {
+	Number  string
+	Balance uint32
+	Expires time.Time
+	Expired bool
+}
+
+type GiftCardTopUp struct {
+	Amount uint32
+}
+
+type Customer struct {
+	Email          string
+	Points         uint32
+	PointValue     uint32
+	Tier           string
+	Spend          uint32
+	ExpiringPoints uint32
+	Expires        *time.Time `json:",omitempty"`
+	Transactions   []LoyaltyTransaction
+}
+
+type LoyaltyTransaction struct {
+	Seq       uint64
+	Type      string
+	Points    int32
+	Balance   uint32
+	OrderID   string `json:",omitempty"`
+	Reference string `json:",omitempty"`
+	Reason    string `json:",omitempty"`
+	Time      time.Time
+}
+
+type LoyaltyLedger struct {
+	Entries []LoyaltyTransaction
+	// Next is passed as before to fetch the next page, 0 when there are no more entries.
+	Next uint64 `json:",omitempty"`
+	// Archived is the position before which entries have been archived.
+	Archived uint64 `json:",omitempty"`
+}
+
+type LoyaltyAdjustment struct {
+	Points int32
+	Reason string
+}
+
+// CustomerErasure is the audit record of a customer being forgotten. Subject is a hash of their
+// email, which is not kept.
+type CustomerErasure struct {
+	Subject        string
+	Reason         string
+	Time           time.Time
+	CustomerFound  bool
+	OrdersScrubbed []string
+	OrdersRetained []string
 }
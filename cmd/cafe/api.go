@@ -10,6 +10,8 @@ import (
 	"go.temporal.io/sdk/client"
 )
 
+var stationRoutingFile string
+
 // apiCmd represents the api command
 var apiCmd = &cobra.Command{
 	Use:   "api",
@@ -21,8 +23,18 @@ var apiCmd = &cobra.Command{
 		}
 		defer c.Close()
 
+		routing, err := loadStationRouting(stationRoutingFile)
+		if err != nil {
+			return err
+		}
+
+		erasureKey, err := loadErasureKey()
+		if err != nil {
+			return err
+		}
+
 		srv := &http.Server{
-			Handler: api.Router(c),
+			Handler: api.Router(c, routing, erasureKey),
 			Addr:    "0.0.0.0:8084",
 		}
 
@@ -45,4 +57,5 @@ var apiCmd = &cobra.Command{
 
 func init() {
 	rootCmd.AddCommand(apiCmd)
+	apiCmd.Flags().StringVar(&stationRoutingFile, "stations", os.Getenv(stationRoutingEnv), "JSON file routing menu items to stations")
 }
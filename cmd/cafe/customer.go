@@ -0,0 +1,236 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/temporalio/temporal-cafe/api"
+)
+
+var customerAdjustReason string
+
+var customerForgetReason string
+
+var customerLedgerBefore uint64
+
+var customerLedgerLimit uint32
+
+// customerCmd represents the customer command
+var customerCmd = &cobra.Command{
+	Use:   "customer",
+	Short: "Customer loyalty commands",
+	CompletionOptions: cobra.CompletionOptions{
+		DisableDefaultCmd: true,
+	},
+}
+
+var customerShowCmd = &cobra.Command{
+	Use:   "show <email>",
+	Short: "Show a customer's loyalty balance, tier and recent transactions",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		r, err := http.Get(fmt.Sprintf("http://localhost:8084/customers/%s", url.PathEscape(args[0])))
+		if err != nil {
+			return err
+		}
+		defer r.Body.Close()
+
+		if r.StatusCode != http.StatusOK {
+			msg, _ := io.ReadAll(r.Body)
+			return fmt.Errorf("api request failed with code %d: %s", r.StatusCode, bytes.TrimSpace(msg))
+		}
+
+		var customer api.Customer
+		err = json.NewDecoder(r.Body).Decode(&customer)
+		if err != nil {
+			return err
+		}
+
+		printCustomer(&customer)
+
+		return nil
+	},
+}
+
+var customerLedgerCmd = &cobra.Command{
+	Use:   "ledger <email>",
+	Short: "List a customer's points ledger, newest first",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		query := url.Values{}
+		if customerLedgerBefore != 0 {
+			query.Set("before", strconv.FormatUint(customerLedgerBefore, 10))
+		}
+		if customerLedgerLimit != 0 {
+			query.Set("limit", strconv.FormatUint(uint64(customerLedgerLimit), 10))
+		}
+
+		r, err := http.Get(fmt.Sprintf("http://localhost:8084/customers/%s/ledger?%s", url.PathEscape(args[0]), query.Encode()))
+		if err != nil {
+			return err
+		}
+		defer r.Body.Close()
+
+		if r.StatusCode != http.StatusOK {
+			msg, _ := io.ReadAll(r.Body)
+			return fmt.Errorf("api request failed with code %d: %s", r.StatusCode, bytes.TrimSpace(msg))
+		}
+
+		var ledger api.LoyaltyLedger
+		err = json.NewDecoder(r.Body).Decode(&ledger)
+		if err != nil {
+			return err
+		}
+
+		printTransactions(ledger.Entries)
+		if ledger.Next != 0 {
+			fmt.Printf("\nMore entries: --before %d\n", ledger.Next)
+		} else if ledger.Archived != 0 {
+			fmt.Printf("\nEntries before %d have been archived\n", ledger.Archived)
+		}
+
+		return nil
+	},
+}
+
+var customerAdjustCmd = &cobra.Command{
+	Use:   "adjust <email> <points>",
+	Short: "Correct a customer's loyalty balance, removing points if negative",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		points, err := strconv.ParseInt(args[1], 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid points: %s", args[1])
+		}
+		if customerAdjustReason == "" {
+			return fmt.Errorf("a reason is required")
+		}
+
+		body, err := json.Marshal(&api.LoyaltyAdjustment{Points: int32(points), Reason: customerAdjustReason})
+		if err != nil {
+			return err
+		}
+
+		r, err := http.Post(
+			fmt.Sprintf("http://localhost:8084/customers/%s/adjustments", url.PathEscape(args[0])),
+			"application/json",
+			bytes.NewReader(body),
+		)
+		if err != nil {
+			return err
+		}
+		defer r.Body.Close()
+
+		if r.StatusCode != http.StatusCreated {
+			msg, _ := io.ReadAll(r.Body)
+			return fmt.Errorf("api request failed with code %d: %s", r.StatusCode, bytes.TrimSpace(msg))
+		}
+
+		var customer api.Customer
+		err = json.NewDecoder(r.Body).Decode(&customer)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Adjusted %s by %d points, balance is now %d\n", customer.Email, points, customer.Points)
+
+		return nil
+	},
+}
+
+var customerForgetCmd = &cobra.Command{
+	Use:   "forget <email>",
+	Short: "Erase a customer's loyalty account and their details from their orders",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if customerForgetReason == "" {
+			return fmt.Errorf("a reason is required")
+		}
+
+		query := url.Values{}
+		query.Set("reason", customerForgetReason)
+
+		req, err := http.NewRequest(
+			http.MethodDelete,
+			fmt.Sprintf("http://localhost:8084/customers/%s?%s", url.PathEscape(args[0]), query.Encode()),
+			nil,
+		)
+		if err != nil {
+			return err
+		}
+
+		r, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer r.Body.Close()
+
+		if r.StatusCode != http.StatusOK {
+			msg, _ := io.ReadAll(r.Body)
+			return fmt.Errorf("api request failed with code %d: %s", r.StatusCode, bytes.TrimSpace(msg))
+		}
+
+		var erasure api.CustomerErasure
+		err = json.NewDecoder(r.Body).Decode(&erasure)
+		if err != nil {
+			return err
+		}
+
+		if !erasure.CustomerFound {
+			fmt.Printf("No loyalty account found for %s\n", args[0])
+		}
+		fmt.Printf("Forgot %s, recorded as %s\n", args[0], erasure.Subject)
+		fmt.Printf("Orders scrubbed: %d\n", len(erasure.OrdersScrubbed))
+		fmt.Printf("Orders retained: %d\n", len(erasure.OrdersRetained))
+
+		return nil
+	},
+}
+
+func printCustomer(c *api.Customer) {
+	fmt.Printf("Email:  %s\n", c.Email)
+	fmt.Printf("Points: %d\n", c.Points)
+	if c.Tier != "" {
+		fmt.Printf("Tier:   %s\n", c.Tier)
+	}
+	fmt.Printf("Spend:  $%.2f\n", float32(c.Spend)/100)
+	if c.Expires != nil {
+		fmt.Printf("Expiring: %d points on %s\n", c.ExpiringPoints, c.Expires.Format("2006-01-02"))
+	}
+
+	if len(c.Transactions) == 0 {
+		return
+	}
+
+	fmt.Println()
+	printTransactions(c.Transactions)
+}
+
+func printTransactions(transactions []api.LoyaltyTransaction) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "#\tTIME\tTYPE\tPOINTS\tBALANCE\tORDER\tREASON")
+	for _, t := range transactions {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%+d\t%d\t%s\t%s\n", t.Seq, t.Time.Format("2006-01-02 15:04"), t.Type, t.Points, t.Balance, t.OrderID, t.Reason)
+	}
+	w.Flush()
+}
+
+func init() {
+	customerAdjustCmd.Flags().StringVarP(&customerAdjustReason, "reason", "r", "", "reason for the adjustment")
+	customerForgetCmd.Flags().StringVarP(&customerForgetReason, "reason", "r", "", "reason for forgetting the customer")
+	customerLedgerCmd.Flags().Uint64Var(&customerLedgerBefore, "before", 0, "only list entries before this position")
+	customerLedgerCmd.Flags().Uint32Var(&customerLedgerLimit, "limit", 20, "number of entries to list")
+	customerCmd.AddCommand(customerShowCmd)
+	customerCmd.AddCommand(customerLedgerCmd)
+	customerCmd.AddCommand(customerAdjustCmd)
+	customerCmd.AddCommand(customerForgetCmd)
+	rootCmd.AddCommand(customerCmd)
+}
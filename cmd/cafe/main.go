@@ -1,6 +1,8 @@
 package main
 
 import (
+	"os"
+
 	"github.com/spf13/cobra"
 )
 
@@ -14,5 +16,10 @@ var rootCmd = &cobra.Command{
 }
 
 func main() {
+	// Station commands depend on the routing, so they are added before the command line is parsed.
+	routing, err := loadStationRouting(os.Getenv(stationRoutingEnv))
+	cobra.CheckErr(err)
+	cobra.CheckErr(addStationCmds(routing))
+
 	cobra.CheckErr(rootCmd.Execute())
 }
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/temporalio/temporal-cafe/api"
+	"gopkg.in/yaml.v3"
+)
+
+var menuFormat string
+
+// menuCmd represents the menu command
+var menuCmd = &cobra.Command{
+	Use:   "menu",
+	Short: "Menu commands",
+	CompletionOptions: cobra.CompletionOptions{
+		DisableDefaultCmd: true,
+	},
+}
+
+var menuExportCmd = &cobra.Command{
+	Use:   "export [file]",
+	Short: "Export the menu as YAML or JSON",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		r, err := http.Get("http://localhost:8084/menu")
+		if err != nil {
+			return err
+		}
+		defer r.Body.Close()
+
+		if r.StatusCode != http.StatusOK {
+			return fmt.Errorf("api request failed with code: %d", r.StatusCode)
+		}
+
+		var menu api.Menu
+		err = json.NewDecoder(r.Body).Decode(&menu)
+		if err != nil {
+			return err
+		}
+
+		var file string
+		if len(args) > 0 {
+			file = args[0]
+		}
+
+		var out []byte
+		switch menuFileFormat(file) {
+		case "yaml":
+			out, err = yaml.Marshal(&menu)
+		case "json":
+			out, err = json.MarshalIndent(&menu, "", "  ")
+		default:
+			err = fmt.Errorf("unknown format: %s", menuFormat)
+		}
+		if err != nil {
+			return err
+		}
+
+		if file == "" {
+			_, err = os.Stdout.Write(out)
+			return err
+		}
+
+		return os.WriteFile(file, out, 0644)
+	},
+}
+
+var menuImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Replace the menu with one read from a YAML or JSON file",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		file := args[0]
+
+		var in []byte
+		var err error
+		if file == "-" {
+			in, err = io.ReadAll(os.Stdin)
+		} else {
+			in, err = os.ReadFile(file)
+		}
+		if err != nil {
+			return err
+		}
+
+		var menu api.Menu
+		switch menuFileFormat(file) {
+		case "yaml":
+			err = yaml.Unmarshal(in, &menu)
+		case "json":
+			err = json.Unmarshal(in, &menu)
+		default:
+			err = fmt.Errorf("unknown format: %s", menuFormat)
+		}
+		if err != nil {
+			return err
+		}
+
+		body, err := json.Marshal(&menu)
+		if err != nil {
+			return err
+		}
+
+		req, err := http.NewRequest(http.MethodPut, "http://localhost:8084/menu", bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		r, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer r.Body.Close()
+
+		if r.StatusCode != http.StatusOK {
+			msg, _ := io.ReadAll(r.Body)
+			return fmt.Errorf("api request failed with code %d: %s", r.StatusCode, bytes.TrimSpace(msg))
+		}
+
+		fmt.Printf("Imported %d menu items\n", len(menu.Items))
+
+		return nil
+	},
+}
+
+// menuFileFormat returns the format given by flag, falling back to the file extension.
+func menuFileFormat(file string) string {
+	if menuFormat != "" {
+		return menuFormat
+	}
+
+	switch filepath.Ext(file) {
+	case ".json":
+		return "json"
+	default:
+		return "yaml"
+	}
+}
+
+func init() {
+	menuCmd.PersistentFlags().StringVarP(&menuFormat, "format", "f", "", "file format: yaml or json (default from file extension, otherwise yaml)")
+	menuCmd.AddCommand(menuExportCmd)
+	menuCmd.AddCommand(menuImportCmd)
+	rootCmd.AddCommand(menuCmd)
+}
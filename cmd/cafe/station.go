@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+	"github.com/temporalio/temporal-cafe/cmd/cafe/ui"
+	"github.com/temporalio/temporal-cafe/proto"
+	"github.com/temporalio/temporal-cafe/workflows"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// stationRoutingEnv names the station routing file shared by the API server and station commands.
+const stationRoutingEnv = "CAFE_STATIONS"
+
+// loadStationRouting reads the station routing from a JSON file, using the default routing if no
+// file is given.
+func loadStationRouting(path string) (*proto.StationRouting, error) {
+	if path == "" {
+		return workflows.DefaultStationRouting(), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var routing proto.StationRouting
+	err = protojson.Unmarshal(data, &routing)
+	if err != nil {
+		return nil, err
+	}
+
+	err = workflows.ValidateStationRouting(&routing)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return &routing, nil
+}
+
+// stationCmd returns the command for a station, such as the kitchen or barista
+func stationCmd(station string) *cobra.Command {
+	title := strings.ToUpper(station[:1]) + station[1:]
+
+	cmd := &cobra.Command{
+		Use:   station,
+		Short: fmt.Sprintf("%s commands", title),
+		CompletionOptions: cobra.CompletionOptions{
+			DisableDefaultCmd: true,
+		},
+	}
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "board",
+		Short: fmt.Sprintf("Show %s order board", station),
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			b := ui.StationBoard{Station: station}
+
+			f, err := tea.LogToFile("debug.log", "debug")
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			p := tea.NewProgram(b, tea.WithAltScreen())
+			_, err = p.Run()
+
+			return err
+		},
+	})
+
+	return cmd
+}
+
+// addStationCmds adds a command for each station in the routing.
+func addStationCmds(routing *proto.StationRouting) error {
+	for _, station := range workflows.Stations(routing) {
+		for _, c := range rootCmd.Commands() {
+			if c.Name() == station {
+				return fmt.Errorf("station name clashes with command: %s", station)
+			}
+		}
+		rootCmd.AddCommand(stationCmd(station))
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 
@@ -32,6 +33,13 @@ var (
 
 	focusItemStyle = itemStyle.Copy().
 			Foreground(lipgloss.Color("#ee6ff8"))
+
+	unavailableItemStyle = itemStyle.Copy().
+				Faint(true).
+				Strikethrough(true)
+
+	modifierRowStyle = lipgloss.NewStyle().
+				MarginLeft(2)
 )
 
 type Menu struct {
@@ -46,16 +54,19 @@ type menuMsg struct {
 }
 
 type incItemCountMsg struct {
-	item *api.MenuItem
+	item      *api.MenuItem
+	modifiers []api.OrderItemModifier
 }
 
 type decItemCountMsg struct {
-	item *api.MenuItem
+	item      *api.MenuItem
+	modifiers []api.OrderItemModifier
 }
 
 type setItemCountMsg struct {
-	item  *api.MenuItem
-	count uint32
+	item      *api.MenuItem
+	modifiers []api.OrderItemModifier
+	count     uint32
 }
 
 func newMenu() Menu {
@@ -83,11 +94,12 @@ func (m Menu) Update(msg tea.Msg) (Menu, tea.Cmd) {
 		return m, m.updateFocused(msg)
 	case menuMsg:
 		m.menu = msg.menu
+		m.items = nil
 		for i := range m.menu.Items {
 			m.items = append(m.items, newMenuItemDelegate(&m.menu.Items[i]))
 		}
 		m.focusItem = 0
-		if m.focus {
+		if m.focus && len(m.items) > 0 {
 			return m, m.items[m.focusItem].Focus()
 		}
 
@@ -136,6 +148,31 @@ func (m *Menu) fetchMenu() tea.Msg {
 	return menuMsg{menu: &menuJSON}
 }
 
+// setMenuItemAvailability marks a menu item as available or sold out.
+func setMenuItemAvailability(name string, available bool) tea.Cmd {
+	return func() tea.Msg {
+		c := &http.Client{}
+		r, err := c.Post(
+			fmt.Sprintf("http://localhost:8084/menu/items/%s/availability", url.PathEscape(name)),
+			"text/plain",
+			strings.NewReader(strconv.FormatBool(available)),
+		)
+		if err != nil {
+			return statusMsg{err: err}
+		}
+		defer r.Body.Close()
+
+		if r.StatusCode < 200 || r.StatusCode >= 300 {
+			return statusMsg{err: fmt.Errorf("api request failed with code: %d", r.StatusCode)}
+		}
+
+		if available {
+			return statusMsg{status: fmt.Sprintf("%s is available", name)}
+		}
+		return statusMsg{status: fmt.Sprintf("%s is sold out", name)}
+	}
+}
+
 func (m *Menu) focusPrevious() tea.Cmd {
 	if m.focusItem == 0 {
 		return nil
@@ -208,11 +245,27 @@ func newMenuItemDelegate(item *api.MenuItem) menuItemDelegate {
 		{label: "x", id: "reset"},
 	}
 
+	for _, g := range item.ModifierGroups {
+		for _, m := range g.Modifiers {
+			d.modifiers = append(d.modifiers, &modifierOption{group: g.Name, max: g.Max, modifier: m})
+			d.buttons = append(d.buttons, button{label: m.Name, id: fmt.Sprintf("modifier-%d", len(d.modifiers)-1)})
+		}
+	}
+
 	return d
 }
 
+// modifierOption is a modifier which can be toggled on or off for the next items added to the order.
+type modifierOption struct {
+	group    string
+	max      uint32
+	modifier api.MenuModifier
+	selected bool
+}
+
 type menuItemDelegate struct {
 	item        *api.MenuItem
+	modifiers   []*modifierOption
 	focus       bool
 	buttons     []button
 	buttonFocus int
@@ -225,6 +278,22 @@ func (m menuItemDelegate) Init() tea.Cmd {
 func (m menuItemDelegate) Update(msg tea.Msg) (menuItemDelegate, tea.Cmd) {
 	log.Printf("Menu Item: %v", msg)
 
+	// Sold out items can only be taken off the order.
+	if !m.item.Available {
+		switch msg := msg.(type) {
+		case tea.KeyMsg:
+			switch msg.String() {
+			case "left", "right", "enter", "space":
+			default:
+				return m, nil
+			}
+		case clickMsg:
+			if msg.id == "inc" {
+				return m, nil
+			}
+		}
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch msg.String() {
@@ -250,36 +319,100 @@ func (m menuItemDelegate) Update(msg tea.Msg) (menuItemDelegate, tea.Cmd) {
 		case "reset":
 			return m, m.Set(0)
 		}
+
+		var i int
+		if _, err := fmt.Sscanf(msg.id, "modifier-%d", &i); err == nil && i < len(m.modifiers) {
+			m.toggleModifier(i)
+		}
 	}
 
 	return m, nil
 }
 
+// toggleModifier selects or deselects a modifier. Selecting a modifier in a full group
+// deselects the others in that group.
+func (m *menuItemDelegate) toggleModifier(i int) {
+	option := m.modifiers[i]
+	if option.selected {
+		option.selected = false
+		m.buttons[len(m.buttons)-len(m.modifiers)+i].label = option.modifier.Name
+		return
+	}
+
+	var selected uint32
+	for _, o := range m.modifiers {
+		if o.group == option.group && o.selected {
+			selected++
+		}
+	}
+	if selected >= option.max {
+		for j, o := range m.modifiers {
+			if o.group == option.group && o.selected {
+				o.selected = false
+				m.buttons[len(m.buttons)-len(m.modifiers)+j].label = o.modifier.Name
+			}
+		}
+	}
+
+	option.selected = true
+	m.buttons[len(m.buttons)-len(m.modifiers)+i].label = "✓" + option.modifier.Name
+}
+
+func (m *menuItemDelegate) selectedModifiers() []api.OrderItemModifier {
+	var modifiers []api.OrderItemModifier
+	for _, o := range m.modifiers {
+		if o.selected {
+			modifiers = append(modifiers, api.OrderItemModifier{
+				Group:      o.group,
+				Name:       o.modifier.Name,
+				PriceDelta: o.modifier.PriceDelta,
+			})
+		}
+	}
+
+	return modifiers
+}
+
 func (m menuItemDelegate) View() string {
 	s := itemStyle.Render
-	if m.focus {
+	if !m.item.Available {
+		s = unavailableItemStyle.Render
+	} else if m.focus {
 		s = focusItemStyle.Render
 	}
 
+	controls := len(m.buttons) - len(m.modifiers)
+
 	out := []string{s(m.item.Name)}
-	for i := range m.buttons {
+	for i := range m.buttons[:controls] {
 		out = append(out, m.buttons[i].View())
 	}
 
-	return lipgloss.JoinHorizontal(lipgloss.Top, out...)
+	row := lipgloss.JoinHorizontal(lipgloss.Top, out...)
+	if len(m.modifiers) == 0 {
+		return row
+	}
+
+	var modifiers []string
+	for i := range m.buttons[controls:] {
+		modifiers = append(modifiers, m.buttons[controls+i].View())
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, row, modifierRowStyle.Render(lipgloss.JoinHorizontal(lipgloss.Top, modifiers...)))
 }
 
 func (m *menuItemDelegate) Inc() tea.Msg {
-	return incItemCountMsg{item: m.item}
+	return incItemCountMsg{item: m.item, modifiers: m.selectedModifiers()}
 }
 
 func (m *menuItemDelegate) Dec() tea.Msg {
-	return decItemCountMsg{item: m.item}
+	return decItemCountMsg{item: m.item, modifiers: m.selectedModifiers()}
 }
 
 func (m *menuItemDelegate) Set(c uint32) tea.Cmd {
+	modifiers := m.selectedModifiers()
 	return func() tea.Msg {
-		return setItemCountMsg{item: m.item, count: c}
+		return setItemCountMsg{item: m.item, modifiers: modifiers, count: c}
 	}
 }
 
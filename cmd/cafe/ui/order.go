@@ -2,11 +2,15 @@ package ui
 
 import (
 	"bytes"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
+	"net/url"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
@@ -29,18 +33,30 @@ type orderMsg struct {
 	err    error
 }
 
+// loyaltyMsg carries the loyalty account for an email address, or nil if the customer has none.
+type loyaltyMsg struct {
+	email   string
+	account *api.Customer
+	err     error
+}
+
 type orderFocusField int
 
 const (
 	nameField orderFocusField = iota
 	emailField
+	pointsButton
 	submitButton
 )
 
 type Order struct {
 	order      api.Order
+	key        string
 	name       textinput.Model
 	email      textinput.Model
+	loyalty    *api.Customer
+	usePoints  bool
+	points     button
 	submit     button
 	focus      bool
 	focusField orderFocusField
@@ -57,6 +73,7 @@ func newOrder() Order {
 		email: email,
 	}
 
+	order.points = button{label: "Use Points", id: "points"}
 	order.submit = button{label: "Place Order", id: "submit"}
 
 	return order
@@ -85,7 +102,30 @@ func (m Order) Update(msg tea.Msg) (Order, tea.Cmd) {
 			return m, m.updateFocused(msg)
 		}
 	case clickMsg:
+		if msg.id == m.points.id {
+			m.usePoints = !m.usePoints
+			m.points.label = "Use Points"
+			if m.usePoints {
+				m.points.label = "Don't Use Points"
+			}
+			return m, nil
+		}
+		// Retries of the same cart reuse the key so that the order is only placed once.
+		if m.key == "" {
+			m.key = newIdempotencyKey()
+		}
 		return m, m.placeOrder
+	case loyaltyMsg:
+		// Ignore balances for an email address which has since been changed.
+		if msg.email != m.email.Value() {
+			return m, nil
+		}
+		m.loyalty = msg.account
+		if !m.hasPoints() {
+			m.usePoints = false
+			m.points.label = "Use Points"
+		}
+		return m, nil
 	}
 	return m, nil
 }
@@ -96,6 +136,13 @@ func (m Order) View() string {
 		m.name.View(),
 		m.email.View(),
 	}
+	if m.loyalty != nil {
+		points := fmt.Sprintf("Points: %d (%s)", m.loyalty.Points, formatPrice(m.loyalty.Points*m.loyalty.PointValue))
+		if m.loyalty.Tier != "" {
+			points += fmt.Sprintf(" %s", m.loyalty.Tier)
+		}
+		out = append(out, points)
+	}
 
 	items := []string{}
 	total := uint32(0)
@@ -105,11 +152,26 @@ func (m Order) View() string {
 		}
 		total += item.Price * item.Count
 		items = append(items, fmt.Sprintf("%s (%s) x %d", item.Name, formatPrice(item.Price), item.Count))
+		if len(item.Modifiers) > 0 {
+			var modifiers []string
+			for _, m := range item.Modifiers {
+				modifiers = append(modifiers, m.Name)
+			}
+			items = append(items, "  "+strings.Join(modifiers, ", "))
+		}
 	}
 	items = append(items, "", fmt.Sprintf("Total: %s", formatPrice(total)))
+	if m.usePoints {
+		points := m.pointsAmount(total)
+		items = append(items, fmt.Sprintf("Points: -%s", formatPrice(points)), fmt.Sprintf("Card: %s", formatPrice(total-points)))
+	}
 
 	if len(items) > 0 {
-		out = append(out, "", lipgloss.JoinVertical(lipgloss.Left, items...), "", m.submit.View())
+		out = append(out, "", lipgloss.JoinVertical(lipgloss.Left, items...), "")
+		if m.hasPoints() {
+			out = append(out, m.points.View())
+		}
+		out = append(out, m.submit.View())
 	}
 
 	return orderFrame.Render(
@@ -117,6 +179,12 @@ func (m Order) View() string {
 	)
 }
 
+func newIdempotencyKey() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
 func formatPrice(p uint32) string {
 	return fmt.Sprintf("$%.2f", float32(p)/100)
 }
@@ -129,6 +197,8 @@ func (m *Order) updateFocused(msg tea.Msg) tea.Cmd {
 		m.name, cmd = m.name.Update(msg)
 	case emailField:
 		m.email, cmd = m.email.Update(msg)
+	case pointsButton:
+		m.points, cmd = m.points.Update(msg)
 	case submitButton:
 		m.submit, cmd = m.submit.Update(msg)
 	}
@@ -147,6 +217,8 @@ func (m *Order) FocusField() tea.Cmd {
 		return m.name.Focus()
 	case emailField:
 		return m.email.Focus()
+	case pointsButton:
+		return m.points.Focus()
 	case submitButton:
 		return m.submit.Focus()
 	}
@@ -158,9 +230,7 @@ func (m *Order) focusNext() tea.Cmd {
 		return nil
 	}
 
-	m.BlurField()
-	m.focusField += 1
-	return m.FocusField()
+	return m.moveFocus(1)
 }
 
 func (m *Order) focusPrevious() tea.Cmd {
@@ -168,9 +238,26 @@ func (m *Order) focusPrevious() tea.Cmd {
 		return nil
 	}
 
+	return m.moveFocus(-1)
+}
+
+// moveFocus moves to the next field in the given direction, skipping the points button when the
+// customer has no points to use. The customer's balance is looked up on leaving the email field.
+func (m *Order) moveFocus(delta orderFocusField) tea.Cmd {
+	leaving := m.focusField
+
 	m.BlurField()
-	m.focusField -= 1
-	return m.FocusField()
+	m.focusField += delta
+	if m.focusField == pointsButton && !m.hasPoints() {
+		m.focusField += delta
+	}
+	cmd := m.FocusField()
+
+	if leaving == emailField {
+		m.loyalty = nil
+		return tea.Batch(cmd, fetchLoyalty(m.email.Value()))
+	}
+	return cmd
 }
 
 func (m *Order) Blur() {
@@ -184,80 +271,189 @@ func (m *Order) BlurField() {
 		m.name.Blur()
 	case emailField:
 		m.email.Blur()
+	case pointsButton:
+		m.points.Blur()
 	case submitButton:
 		m.submit.Blur()
 	}
 }
 
-func (m *Order) IncItemCount(item *api.MenuItem) {
-	for i := range m.order.Items {
-		if item.Type == m.order.Items[i].Type && item.Name == m.order.Items[i].Name {
-			m.order.Items[i].Count += 1
-			return
+func (m *Order) hasPoints() bool {
+	return m.loyalty != nil && m.loyalty.Points > 0
+}
+
+// pointsAmount is how much of total the customer's points will pay for.
+func (m *Order) pointsAmount(total uint32) uint32 {
+	if !m.hasPoints() {
+		return 0
+	}
+
+	amount := m.loyalty.Points * m.loyalty.PointValue
+	if amount > total {
+		amount = total - total%m.loyalty.PointValue
+	}
+
+	return amount
+}
+
+func fetchLoyalty(email string) tea.Cmd {
+	if email == "" {
+		return nil
+	}
+
+	return func() tea.Msg {
+		r, err := http.Get(fmt.Sprintf("http://localhost:8084/customers/%s", url.PathEscape(email)))
+		if err != nil {
+			return loyaltyMsg{email: email, err: err}
+		}
+		defer r.Body.Close()
+
+		if r.StatusCode == http.StatusNotFound {
+			return loyaltyMsg{email: email}
 		}
+		if r.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(r.Body)
+			return loyaltyMsg{email: email, err: fmt.Errorf("%s: %s", http.StatusText(r.StatusCode), body)}
+		}
+
+		var account api.Customer
+		err = json.NewDecoder(r.Body).Decode(&account)
+		if err != nil {
+			return loyaltyMsg{email: email, err: err}
+		}
+
+		return loyaltyMsg{email: email, account: &account}
 	}
-	m.order.Items = append(m.order.Items, api.OrderItem{
-		Type: item.Type, Name: item.Name, Price: item.Price, Count: 1,
-	})
 }
 
-func (m *Order) DecItemCount(item *api.MenuItem) {
+// orderItem returns the order line for a menu item with the given modifiers, if there is one.
+func (m *Order) orderItem(item *api.MenuItem, modifiers []api.OrderItemModifier) *api.OrderItem {
 	for i := range m.order.Items {
-		if item.Type == m.order.Items[i].Type && item.Name == m.order.Items[i].Name {
-			if m.order.Items[i].Count > 0 {
-				m.order.Items[i].Count -= 1
+		oi := &m.order.Items[i]
+		if item.Type != oi.Type || item.Name != oi.Name || len(modifiers) != len(oi.Modifiers) {
+			continue
+		}
+
+		same := true
+		for j := range modifiers {
+			if modifiers[j].Name != oi.Modifiers[j].Name {
+				same = false
 			}
-			return
 		}
+		if same {
+			return oi
+		}
+	}
+
+	return nil
+}
+
+// itemPrice is the price of a menu item with modifiers, as shown on the order.
+// The API works out the price charged.
+func itemPrice(item *api.MenuItem, modifiers []api.OrderItemModifier) uint32 {
+	price := int32(item.Price)
+	for _, m := range modifiers {
+		price += m.PriceDelta
 	}
+	if price < 0 {
+		return 0
+	}
+
+	return uint32(price)
 }
 
-func (m *Order) SetItemCount(item *api.MenuItem, count uint32) {
-	for i := range m.order.Items {
-		if item.Type == m.order.Items[i].Type && item.Name == m.order.Items[i].Name {
-			m.order.Items[i].Count = count
-			return
+func (m *Order) IncItemCount(item *api.MenuItem, modifiers []api.OrderItemModifier) {
+	if oi := m.orderItem(item, modifiers); oi != nil {
+		oi.Count += 1
+		return
+	}
+	m.order.Items = append(m.order.Items, api.OrderItem{
+		Type: item.Type, Name: item.Name, Price: itemPrice(item, modifiers), Count: 1, Modifiers: modifiers,
+	})
+}
+
+func (m *Order) DecItemCount(item *api.MenuItem, modifiers []api.OrderItemModifier) {
+	if oi := m.orderItem(item, modifiers); oi != nil {
+		if oi.Count > 0 {
+			oi.Count -= 1
 		}
 	}
+}
+
+func (m *Order) SetItemCount(item *api.MenuItem, modifiers []api.OrderItemModifier, count uint32) {
+	if oi := m.orderItem(item, modifiers); oi != nil {
+		oi.Count = count
+		return
+	}
 	if count == 0 {
 		return
 	}
 
 	m.order.Items = append(m.order.Items, api.OrderItem{
-		Type: item.Type, Name: item.Name, Price: item.Price, Count: count,
+		Type: item.Type, Name: item.Name, Price: itemPrice(item, modifiers), Count: count, Modifiers: modifiers,
 	})
 }
 
 func (m *Order) placeOrder() tea.Msg {
 	m.order.Name = m.name.Value()
 	m.order.Email = m.email.Value()
+	m.order.Tenders = nil
+	if m.usePoints {
+		var total uint32
+		for _, item := range m.order.Items {
+			total += item.Price * item.Count
+		}
+		if points := m.pointsAmount(total); points > 0 {
+			m.order.Tenders = []api.Tender{
+				{Type: "loyalty_points", Amount: points},
+				{Type: "card"},
+			}
+		}
+	}
 
 	jsonInput, err := json.Marshal(m.order)
 	if err != nil {
 		return orderMsg{err: fmt.Errorf("unable to encode order: %w", err)}
 	}
 
-	c := &http.Client{}
-	r, err := c.Post(
+	req, err := http.NewRequest(
+		http.MethodPost,
 		"http://localhost:8084/orders",
-		"application/json",
 		bytes.NewReader(jsonInput),
 	)
 	if err != nil {
 		return orderMsg{err: err}
 	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Idempotency-Key", m.key)
+
+	c := &http.Client{}
+	r, err := c.Do(req)
+	if err != nil {
+		return orderMsg{err: err}
+	}
 	defer r.Body.Close()
 
-	if r.StatusCode != http.StatusCreated {
+	if r.StatusCode != http.StatusCreated && r.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(r.Body)
 		return orderMsg{err: fmt.Errorf("%s: %s", http.StatusText(r.StatusCode), body)}
 	}
 
-	return orderMsg{status: "Order placed"}
+	var orderJSON api.Order
+	err = json.NewDecoder(r.Body).Decode(&orderJSON)
+	if err != nil {
+		return orderMsg{err: err}
+	}
+
+	return orderMsg{status: fmt.Sprintf("Order %s placed", orderJSON.ID)}
 }
 
 func (m *Order) Reset() {
 	m.order = api.Order{}
+	m.key = ""
 	m.name.Reset()
 	m.email.Reset()
+	m.loyalty = nil
+	m.usePoints = false
+	m.points.label = "Use Points"
 }
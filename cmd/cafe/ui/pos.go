@@ -64,19 +64,26 @@ func (m POS) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.status, cmd = m.status.Update(msg)
 		return m, cmd
 	case orderMsg:
+		// Refresh the menu so that items which have sold out are shown.
 		if msg.err != nil {
-			return m, m.updateStatus(msg.status, msg.err)
+			return m, tea.Batch(m.menu.fetchMenu, m.updateStatus(msg.status, msg.err))
 		}
 		m.menu.Reset()
 		m.order.Reset()
-		return m, tea.Batch(m.focusMenu(), m.updateStatus(msg.status, msg.err))
+		return m, tea.Batch(m.menu.fetchMenu, m.focusMenu(), m.updateStatus(msg.status, msg.err))
+	case loyaltyMsg:
+		m.order, cmd = m.order.Update(msg)
+		if msg.err != nil {
+			return m, tea.Batch(cmd, m.updateStatus("", msg.err))
+		}
+		return m, cmd
 	case setItemCountMsg:
-		m.order.SetItemCount(msg.item, msg.count)
+		m.order.SetItemCount(msg.item, msg.modifiers, msg.count)
 	case incItemCountMsg:
-		m.order.IncItemCount(msg.item)
+		m.order.IncItemCount(msg.item, msg.modifiers)
 		return m, nil
 	case decItemCountMsg:
-		m.order.DecItemCount(msg.item)
+		m.order.DecItemCount(msg.item, msg.modifiers)
 		return m, nil
 	}
 
@@ -5,30 +5,34 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/temporalio/temporal-cafe/api"
 )
 
-type kitchenOrdersMsg struct {
-	orders []api.KitchenOrder
+type stationOrdersMsg struct {
+	orders []api.StationOrder
 }
 
-type KitchenBoard struct {
-	orders       []kitchenOrder
+// StationBoard shows the open orders for a station, such as the kitchen or barista.
+type StationBoard struct {
+	Station string
+
+	orders       []stationOrder
 	focusedOrder int
 
 	err error
 }
 
-func (m KitchenBoard) Init() tea.Cmd {
+func (m StationBoard) Init() tea.Cmd {
 	return m.fetchOrders
 }
 
-func (m *KitchenBoard) fetchOrders() tea.Msg {
+func (m *StationBoard) fetchOrders() tea.Msg {
 	c := &http.Client{}
-	r, err := c.Get("http://localhost:8084/kitchen/orders")
+	r, err := c.Get(fmt.Sprintf("http://localhost:8084/stations/%s/orders", url.PathEscape(m.Station)))
 	if err != nil {
 		log.Printf("Error: %v", err)
 		return statusMsg{err: err}
@@ -39,20 +43,20 @@ func (m *KitchenBoard) fetchOrders() tea.Msg {
 		return statusMsg{err: fmt.Errorf("api request failed with code: %d", r.StatusCode)}
 	}
 
-	var ordersJSON []api.KitchenOrder
+	var ordersJSON []api.StationOrder
 	err = json.NewDecoder(r.Body).Decode(&ordersJSON)
 	if err != nil {
 		return statusMsg{err: err}
 	}
 
-	return kitchenOrdersMsg{ordersJSON}
+	return stationOrdersMsg{ordersJSON}
 }
 
-func (m *KitchenBoard) parseOrders(ordersJSON []api.KitchenOrder) {
-	var orders []kitchenOrder
+func (m *StationBoard) parseOrders(ordersJSON []api.StationOrder) {
+	var orders []stationOrder
 
 	for _, o := range ordersJSON {
-		order := kitchenOrder{}
+		order := stationOrder{station: m.Station}
 		order.parseOrder(o)
 		orders = append(orders, order)
 	}
@@ -60,7 +64,7 @@ func (m *KitchenBoard) parseOrders(ordersJSON []api.KitchenOrder) {
 	m.orders = orders
 }
 
-func (m KitchenBoard) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+func (m StationBoard) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	log.Printf("Board: %v", msg)
 
 	var cmd tea.Cmd
@@ -82,12 +86,12 @@ func (m KitchenBoard) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, cmd
 			}
 		}
-	case kitchenOrdersMsg:
+	case stationOrdersMsg:
 		m.parseOrders(msg.orders)
 		if len(m.orders) > 0 {
 			m.orders[0].Focus()
 		}
-	case kitchenOrderMsg:
+	case stationOrderMsg:
 		for i := range m.orders {
 			if msg.order.ID == m.orders[i].id {
 				m.orders[i], cmd = m.orders[i].Update(msg)
@@ -99,7 +103,7 @@ func (m KitchenBoard) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
-func (m *KitchenBoard) NextOrder() {
+func (m *StationBoard) NextOrder() {
 	if m.focusedOrder < len(m.orders)-1 {
 		m.orders[m.focusedOrder].Blur()
 		m.focusedOrder += 1
@@ -107,7 +111,7 @@ func (m *KitchenBoard) NextOrder() {
 	}
 }
 
-func (m *KitchenBoard) PreviousOrder() {
+func (m *StationBoard) PreviousOrder() {
 	if m.focusedOrder > 0 {
 		m.orders[m.focusedOrder].Blur()
 		m.focusedOrder -= 1
@@ -115,7 +119,7 @@ func (m *KitchenBoard) PreviousOrder() {
 	}
 }
 
-func (m KitchenBoard) View() string {
+func (m StationBoard) View() string {
 	s := lipgloss.NewStyle().Padding(1, 2, 1, 2)
 
 	if m.err != nil {
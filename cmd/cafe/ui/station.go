@@ -0,0 +1,311 @@
+package ui
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/temporalio/temporal-cafe/api"
+)
+
+var (
+	stationOrderStyle = lipgloss.NewStyle().
+				Width(32).
+				Border(lipgloss.NormalBorder(), true)
+
+	stationFocusedOrderStyle = stationOrderStyle.Copy().
+					BorderForeground(lipgloss.Color("#035afc"))
+
+	stationListHeaderStyle = lipgloss.NewStyle().
+				BorderStyle(lipgloss.NormalBorder()).
+				BorderBottom(true).
+				MarginLeft(1)
+
+	stationCursorStyle = lipgloss.NewStyle().
+				MarginLeft(1).
+				MarginRight(1)
+	stationCursorFocusedStyle = stationCursorStyle.Copy().
+					Foreground(lipgloss.Color("#035afc"))
+
+	stationMarkStyle = lipgloss.NewStyle().
+				MarginRight(1)
+
+	stationListItemStyle          = lipgloss.NewStyle()
+	stationListItemCompletedStyle = lipgloss.NewStyle().Strikethrough(true)
+	stationListItemCancelledStyle = lipgloss.NewStyle().Strikethrough(true).Faint(true)
+	stationListItemModifierStyle  = lipgloss.NewStyle().MarginLeft(4).Faint(true)
+	stationListItemRemakeStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("#ff8c00"))
+)
+
+type stationOrderMsg struct {
+	order api.StationOrder
+}
+
+type stationOrder struct {
+	station string
+	id      string
+	open    bool
+	focus   bool
+	// admitted is set once the station's queue lets the order through. Until then its items
+	// can't be started.
+	admitted bool
+	position uint32
+
+	name      string
+	items     []stationOrderItem
+	focusItem int
+}
+
+type stationOrderItem struct {
+	name      string
+	status    string
+	modifiers []string
+
+	remakeReason string
+	remakes      uint32
+}
+
+func (i stationOrderItem) NextStatus() string {
+	switch i.status {
+	case "pending":
+		return "started"
+	case "started":
+		return "completed"
+	}
+
+	return ""
+}
+
+// FailStatus is the status for an item which could not be made, or "" if it is already finished.
+func (i stationOrderItem) FailStatus() string {
+	switch i.status {
+	case "pending", "started":
+		return "failed"
+	}
+
+	return ""
+}
+
+func itemCursor(focus bool) string {
+	if focus {
+		return stationCursorFocusedStyle.Render("●")
+	}
+
+	return stationCursorStyle.Render("∙")
+}
+
+func itemMark(item stationOrderItem) string {
+	mark := " "
+	s := stationMarkStyle
+
+	if item.status == "started" {
+		mark = ">"
+		s = stationMarkStyle.Copy().Foreground(lipgloss.Color("#ffbf00"))
+	} else if item.status == "completed" {
+		mark = "✓"
+		s = stationMarkStyle.Copy().Foreground(lipgloss.Color("#00ff00"))
+	} else if item.status == "cancelled" {
+		mark = "x"
+		s = stationMarkStyle.Copy().Faint(true)
+	} else if item.status == "failed" {
+		mark = "!"
+		s = stationMarkStyle.Copy().Foreground(lipgloss.Color("#ff0000"))
+	} else if item.status == "remade" {
+		mark = "↺"
+		s = stationMarkStyle.Copy().Faint(true)
+	}
+
+	return s.Render(mark)
+}
+
+func itemName(item stationOrderItem) string {
+	if item.status == "completed" {
+		return stationListItemCompletedStyle.Render(item.name)
+	}
+	if item.status == "cancelled" || item.status == "failed" || item.status == "remade" {
+		return stationListItemCancelledStyle.Render(item.name)
+	}
+	if item.remakes > 0 {
+		return stationListItemRemakeStyle.Render(fmt.Sprintf("%s (remake %d)", item.name, item.remakes))
+	}
+
+	return stationListItemStyle.Render(item.name)
+}
+
+func boardMark(board stationOrder) string {
+	mark := " "
+	s := stationMarkStyle
+	switch {
+	case !board.open:
+		mark = "✓"
+		s = stationMarkStyle.Copy().Foreground(lipgloss.Color("#00ff00"))
+	case !board.admitted:
+		mark = "⧗"
+		s = stationMarkStyle.Copy().Faint(true)
+	}
+
+	return s.Render(mark)
+}
+
+func (m stationOrder) Init() tea.Cmd {
+	return nil
+}
+
+func (m *stationOrder) Focus() {
+	m.focus = true
+}
+
+func (m *stationOrder) Blur() {
+	m.focus = false
+}
+
+func (m *stationOrder) NextItem() {
+	if m.focusItem < len(m.items)-1 {
+		m.focusItem += 1
+	}
+}
+
+func (m *stationOrder) PreviousItem() {
+	if m.focusItem > 0 {
+		m.focusItem -= 1
+	}
+}
+
+func (m *stationOrder) parseOrder(orderJSON api.StationOrder) {
+	m.id = orderJSON.ID
+	m.name = orderJSON.Name
+	m.open = orderJSON.Open
+	m.admitted = orderJSON.Admitted
+	m.position = orderJSON.Position
+
+	var items []stationOrderItem
+	for _, i := range orderJSON.Items {
+		items = append(items, stationOrderItem{
+			name:         i.Name,
+			status:       i.Status,
+			modifiers:    i.Modifiers,
+			remakeReason: i.RemakeReason,
+			remakes:      i.Remakes,
+		})
+	}
+
+	m.items = items
+}
+
+func (m *stationOrder) updateItemStatus(line int, status string) tea.Cmd {
+	if status == "" {
+		return nil
+	}
+
+	return func() tea.Msg {
+		c := &http.Client{}
+		r, err := c.Post(
+			fmt.Sprintf("http://localhost:8084/stations/%s/orders/%s/%d/status", url.PathEscape(m.station), m.id, line+1),
+			"text/plain",
+			strings.NewReader(status),
+		)
+		if err != nil {
+			return statusMsg{err: err}
+		}
+		defer r.Body.Close()
+
+		// The order has moved on, for example another board already completed the item.
+		if r.StatusCode == http.StatusConflict {
+			body, _ := io.ReadAll(r.Body)
+			return statusMsg{err: errors.New(strings.TrimSpace(string(body)))}
+		}
+		if r.StatusCode < 200 || r.StatusCode >= 300 {
+			return statusMsg{err: fmt.Errorf("api request failed with code: %d", r.StatusCode)}
+		}
+
+		var orderJSON api.StationOrder
+		err = json.NewDecoder(r.Body).Decode(&orderJSON)
+		if err != nil {
+			return statusMsg{err: err}
+		}
+
+		return stationOrderMsg{orderJSON}
+	}
+}
+
+func (m stationOrder) Update(msg tea.Msg) (stationOrder, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if !m.open || !m.focus {
+			log.Printf("focused? %v", m)
+			return m, nil
+		}
+
+		switch msg.String() {
+		case "enter":
+			status := m.items[m.focusItem].NextStatus()
+			return m, m.updateItemStatus(m.focusItem, status)
+		case "f":
+			// The item couldn't be made. It is remade, or the customer is refunded for it once
+			// it has been remade too many times.
+			return m, m.updateItemStatus(m.focusItem, m.items[m.focusItem].FailStatus())
+		case "8":
+			// "86" the item: mark it sold out so the POS stops selling it.
+			return m, setMenuItemAvailability(m.items[m.focusItem].name, false)
+		case "a":
+			return m, setMenuItemAvailability(m.items[m.focusItem].name, true)
+		case "up":
+			m.PreviousItem()
+		case "down":
+			m.NextItem()
+		}
+	case stationOrderMsg:
+		if msg.order.ID != m.id {
+			return m, nil
+		}
+
+		m.parseOrder(msg.order)
+		if m.focus && !m.open {
+			m.Blur()
+		}
+	}
+
+	return m, nil
+}
+
+func (m stationOrder) View() string {
+	log.Printf("order: %v", m)
+	name := m.name
+	if m.open && m.position > 0 {
+		name = fmt.Sprintf("%d. %s", m.position, m.name)
+	}
+	out := []string{
+		lipgloss.JoinHorizontal(lipgloss.Top, stationListHeaderStyle.Render(name), boardMark(m)),
+	}
+
+	for i, item := range m.items {
+		focused := m.open && m.focus && i == m.focusItem
+		out = append(out, itemCursor(focused)+itemMark(item)+itemName(item))
+		if len(item.modifiers) > 0 {
+			out = append(out, stationListItemModifierStyle.Render(strings.Join(item.modifiers, ", ")))
+		}
+		if item.remakes > 0 && item.remakeReason != "" && item.status != "remade" {
+			out = append(out, stationListItemModifierStyle.Render(item.remakeReason))
+		}
+	}
+
+	s := stationOrderStyle
+	if m.focus {
+		s = stationFocusedOrderStyle
+	}
+
+	if !m.open || !m.admitted {
+		s = s.Copy().Faint(true)
+	}
+
+	return s.Render(
+		lipgloss.JoinVertical(lipgloss.Left, out...),
+	)
+}
@@ -1,7 +1,9 @@
 package main
 
 import (
+	"fmt"
 	"log"
+	"os"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -12,11 +14,56 @@ import (
 	"go.temporal.io/sdk/client"
 	sdktally "go.temporal.io/sdk/contrib/tally"
 	"go.temporal.io/sdk/worker"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/temporalio/temporal-cafe/activities"
+	"github.com/temporalio/temporal-cafe/proto"
 	"github.com/temporalio/temporal-cafe/workflows"
 )
 
+var paymentSimulatorOptions activities.PaymentSimulatorOptions
+
+var loyaltyProgramFile string
+
+var ledgerArchiveDir string
+
+var erasureLogFile string
+
+// erasureKeyEnv names the environment variable holding the secret key customers are identified by
+// when they are forgotten. The API and worker must use the same key.
+const erasureKeyEnv = "CAFE_ERASURE_KEY"
+
+// loadErasureKey reads the erasure key from the environment. There is no default, as a known key
+// would let anyone find a customer's email from the erasure log.
+func loadErasureKey() ([]byte, error) {
+	key := os.Getenv(erasureKeyEnv)
+	if key == "" {
+		return nil, fmt.Errorf("%s must be set", erasureKeyEnv)
+	}
+
+	return []byte(key), nil
+}
+
+// loadLoyaltyProgram reads a loyalty program from a JSON file, using the default program if no file is given.
+func loadLoyaltyProgram(path string) (*proto.LoyaltyProgram, error) {
+	if path == "" {
+		return activities.DefaultLoyaltyProgram(), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var program proto.LoyaltyProgram
+	err = protojson.Unmarshal(data, &program)
+	if err != nil {
+		return nil, err
+	}
+
+	return &program, nil
+}
+
 // workerCmd represents the worker command
 var workerCmd = &cobra.Command{
 	Use:   "worker",
@@ -32,17 +79,58 @@ var workerCmd = &cobra.Command{
 			log.Fatalf("client error: %v", err)
 		}
 		defer c.Close()
-		a := activities.Activities{Client: c}
+
+		program, err := loadLoyaltyProgram(loyaltyProgramFile)
+		if err != nil {
+			log.Fatalf("loyalty program error: %v", err)
+		}
+
+		routing, err := loadStationRouting(stationRoutingFile)
+		if err != nil {
+			log.Fatalf("station routing error: %v", err)
+		}
+
+		erasureKey, err := loadErasureKey()
+		if err != nil {
+			log.Fatalf("erasure key error: %v", err)
+		}
+
+		a := activities.Activities{
+			Client:     c,
+			Payments:   activities.NewPaymentSimulator(paymentSimulatorOptions),
+			Loyalty:    program,
+			Ledger:     activities.NewFileLedgerArchive(ledgerArchiveDir),
+			Erasures:   activities.NewFileErasureLog(erasureLogFile),
+			ErasureKey: erasureKey,
+			Stations:   routing,
+		}
 
 		w := worker.New(c, "cafe", worker.Options{})
 
 		w.RegisterWorkflow(workflows.Order)
-		w.RegisterWorkflow(workflows.KitchenOrder)
-		w.RegisterWorkflow(workflows.BaristaOrder)
-		w.RegisterActivity(a.ProcessPayment)
+		w.RegisterWorkflow(workflows.OrderSequence)
+		w.RegisterWorkflow(workflows.Menu)
+		w.RegisterWorkflow(workflows.StationOrder)
+		w.RegisterWorkflow(workflows.StationQueue)
+		w.RegisterActivity(a.QueueStationOrder)
+		w.RegisterActivity(a.FindClosedStationOrders)
+		w.RegisterActivity(a.AuthorizePayment)
+		w.RegisterActivity(a.CapturePayment)
+		w.RegisterActivity(a.VoidPayment)
 		w.RegisterActivity(a.ProcessPaymentRefund)
 		w.RegisterWorkflow(workflows.Customer)
 		w.RegisterActivity(a.AddLoyaltyPoints)
+		w.RegisterWorkflow(workflows.GiftCard)
+		w.RegisterActivity(a.RedeemGiftCard)
+		w.RegisterActivity(a.RefundGiftCard)
+		w.RegisterActivity(a.RedeemLoyaltyPoints)
+		w.RegisterActivity(a.ReleaseLoyaltyPoints)
+		w.RegisterActivity(a.ArchiveCustomerLedger)
+		w.RegisterWorkflow(workflows.CustomerErasure)
+		w.RegisterActivity(a.ForgetCustomer)
+		w.RegisterActivity(a.ForgetOrder)
+		w.RegisterActivity(a.DeleteCustomerRecords)
+		w.RegisterActivity(a.RecordCustomerErasure)
 
 		err = w.Run(worker.InterruptCh())
 		if err != nil {
@@ -76,5 +164,11 @@ func newPrometheusScope(c prometheus.Configuration) tally.Scope {
 }
 
 func init() {
+	workerCmd.Flags().DurationVar(&paymentSimulatorOptions.Latency, "payment-latency", 0, "latency added to simulated payment requests")
+	workerCmd.Flags().IntVar(&paymentSimulatorOptions.TransientFailures, "payment-transient-failures", 2, "number of times simulated payments with the transient token fail before succeeding")
+	workerCmd.Flags().StringVar(&loyaltyProgramFile, "loyalty-program", "", "JSON file configuring loyalty earning rules, tiers and expiry")
+	workerCmd.Flags().StringVar(&ledgerArchiveDir, "ledger-archive", "ledger-archive", "directory customer ledger entries are archived to")
+	workerCmd.Flags().StringVar(&erasureLogFile, "erasure-log", "erasures.jsonl", "file customer erasures are recorded in")
+	workerCmd.Flags().StringVar(&stationRoutingFile, "stations", os.Getenv(stationRoutingEnv), "JSON file configuring stations and the items routed to them")
 	rootCmd.AddCommand(workerCmd)
 }
@@ -1,9 +1,34 @@
 package proto
 
 const OrderFulfilmentStartedSignal = "order-fulfilment-started"
-const KitchenOrderItemStatusSignal = "kitchen-order-item-status"
-const KitchenOrderStatusQuery = "kitchen-order-status"
-const BaristaOrderItemStatusSignal = "barista-order-item-status"
-const BaristaOrderStatusQuery = "barista-order-status"
+const OrderStatusQuery = "order-status"
+const OrderStationStatusSignal = "order-station-status"
+const OrderCancelUpdate = "order-cancel"
+const OrderModifyUpdate = "order-modify"
+const MenuQuery = "menu"
+const MenuItemAddUpdate = "menu-item-add"
+const MenuItemChangeUpdate = "menu-item-change"
+const MenuItemRemoveUpdate = "menu-item-remove"
+const MenuItemAvailabilityUpdate = "menu-item-availability"
+const MenuReplaceUpdate = "menu-replace"
+const OrderSequenceNextUpdate = "order-sequence-next"
+const StationOrderItemStatusUpdate = "station-order-item-status-update"
+const StationOrderPriorityUpdate = "station-order-priority-update"
+const StationOrderItemsSignal = "station-order-items"
+const StationOrderStatusQuery = "station-order-status"
+const StationOrderAdmittedSignal = "station-order-admitted"
+const StationQueueOrderSignal = "station-queue-order"
+const StationQueueStatusQuery = "station-queue-status"
 const CustomerLoyaltyPointsEarnedSignal = "customer-loyalty-points-earned"
 const CustomerLoyaltyPointsBalanceQuery = "customer-loyalty-points-balance"
+const GiftCardStatusQuery = "gift-card-status"
+const GiftCardTopUpUpdate = "gift-card-top-up"
+const GiftCardRedeemUpdate = "gift-card-redeem"
+const GiftCardRefundUpdate = "gift-card-refund"
+const CustomerLoyaltyPointsRedeemUpdate = "customer-loyalty-points-redeem"
+const CustomerLoyaltyPointsReleaseUpdate = "customer-loyalty-points-release"
+const CustomerLoyaltyPointsAdjustUpdate = "customer-loyalty-points-adjust"
+const CustomerStatusQuery = "customer-status"
+const CustomerLedgerQuery = "customer-ledger"
+const CustomerForgetUpdate = "customer-forget"
+const OrderCustomerForgottenSignal = "order-customer-forgotten"
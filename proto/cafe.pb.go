@@ -9,7 +9,9 @@ package proto
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -70,110 +72,288 @@ func (ProductType) EnumDescriptor() ([]byte, []int) {
 	return file_cafe_proto_rawDescGZIP(), []int{0}
 }
 
-type KitchenOrderItemStatus int32
+type TenderType int32
 
 const (
-	KitchenOrderItemStatus_KITCHEN_ORDER_ITEM_STATUS_PENDING   KitchenOrderItemStatus = 0
-	KitchenOrderItemStatus_KITCHEN_ORDER_ITEM_STATUS_STARTED   KitchenOrderItemStatus = 1
-	KitchenOrderItemStatus_KITCHEN_ORDER_ITEM_STATUS_COMPLETED KitchenOrderItemStatus = 2
-	KitchenOrderItemStatus_KITCHEN_ORDER_ITEM_STATUS_FAILED    KitchenOrderItemStatus = 3
+	TenderType_TENDER_TYPE_UNKNOWN        TenderType = 0
+	TenderType_TENDER_TYPE_CARD           TenderType = 1
+	TenderType_TENDER_TYPE_GIFT_CARD      TenderType = 2
+	TenderType_TENDER_TYPE_LOYALTY_POINTS TenderType = 3
 )
 
-// Enum value maps for KitchenOrderItemStatus.
+// Enum value maps for TenderType.
 var (
-	KitchenOrderItemStatus_name = map[int32]string{
-		0: "KITCHEN_ORDER_ITEM_STATUS_PENDING",
-		1: "KITCHEN_ORDER_ITEM_STATUS_STARTED",
-		2: "KITCHEN_ORDER_ITEM_STATUS_COMPLETED",
-		3: "KITCHEN_ORDER_ITEM_STATUS_FAILED",
-	}
-	KitchenOrderItemStatus_value = map[string]int32{
-		"KITCHEN_ORDER_ITEM_STATUS_PENDING":   0,
-		"KITCHEN_ORDER_ITEM_STATUS_STARTED":   1,
-		"KITCHEN_ORDER_ITEM_STATUS_COMPLETED": 2,
-		"KITCHEN_ORDER_ITEM_STATUS_FAILED":    3,
+	TenderType_name = map[int32]string{
+		0: "TENDER_TYPE_UNKNOWN",
+		1: "TENDER_TYPE_CARD",
+		2: "TENDER_TYPE_GIFT_CARD",
+		3: "TENDER_TYPE_LOYALTY_POINTS",
+	}
+	TenderType_value = map[string]int32{
+		"TENDER_TYPE_UNKNOWN":        0,
+		"TENDER_TYPE_CARD":           1,
+		"TENDER_TYPE_GIFT_CARD":      2,
+		"TENDER_TYPE_LOYALTY_POINTS": 3,
 	}
 )
 
-func (x KitchenOrderItemStatus) Enum() *KitchenOrderItemStatus {
-	p := new(KitchenOrderItemStatus)
+func (x TenderType) Enum() *TenderType {
+	p := new(TenderType)
 	*p = x
 	return p
 }
 
-func (x KitchenOrderItemStatus) String() string {
+func (x TenderType) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (KitchenOrderItemStatus) Descriptor() protoreflect.EnumDescriptor {
+func (TenderType) Descriptor() protoreflect.EnumDescriptor {
 	return file_cafe_proto_enumTypes[1].Descriptor()
 }
 
-func (KitchenOrderItemStatus) Type() protoreflect.EnumType {
+func (TenderType) Type() protoreflect.EnumType {
 	return &file_cafe_proto_enumTypes[1]
 }
 
-func (x KitchenOrderItemStatus) Number() protoreflect.EnumNumber {
+func (x TenderType) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use KitchenOrderItemStatus.Descriptor instead.
-func (KitchenOrderItemStatus) EnumDescriptor() ([]byte, []int) {
+// Deprecated: Use TenderType.Descriptor instead.
+func (TenderType) EnumDescriptor() ([]byte, []int) {
 	return file_cafe_proto_rawDescGZIP(), []int{1}
 }
 
-type BaristaOrderItemStatus int32
+type OrderPaymentStatus int32
 
 const (
-	BaristaOrderItemStatus_BARISTA_ORDER_ITEM_STATUS_PENDING   BaristaOrderItemStatus = 0
-	BaristaOrderItemStatus_BARISTA_ORDER_ITEM_STATUS_STARTED   BaristaOrderItemStatus = 1
-	BaristaOrderItemStatus_BARISTA_ORDER_ITEM_STATUS_COMPLETED BaristaOrderItemStatus = 2
-	BaristaOrderItemStatus_BARISTA_ORDER_ITEM_STATUS_FAILED    BaristaOrderItemStatus = 3
+	OrderPaymentStatus_ORDER_PAYMENT_STATUS_PENDING    OrderPaymentStatus = 0
+	OrderPaymentStatus_ORDER_PAYMENT_STATUS_PAID       OrderPaymentStatus = 1
+	OrderPaymentStatus_ORDER_PAYMENT_STATUS_FAILED     OrderPaymentStatus = 2
+	OrderPaymentStatus_ORDER_PAYMENT_STATUS_REFUNDED   OrderPaymentStatus = 3
+	OrderPaymentStatus_ORDER_PAYMENT_STATUS_AUTHORIZED OrderPaymentStatus = 4
+	OrderPaymentStatus_ORDER_PAYMENT_STATUS_VOIDED     OrderPaymentStatus = 5
 )
 
-// Enum value maps for BaristaOrderItemStatus.
+// Enum value maps for OrderPaymentStatus.
 var (
-	BaristaOrderItemStatus_name = map[int32]string{
-		0: "BARISTA_ORDER_ITEM_STATUS_PENDING",
-		1: "BARISTA_ORDER_ITEM_STATUS_STARTED",
-		2: "BARISTA_ORDER_ITEM_STATUS_COMPLETED",
-		3: "BARISTA_ORDER_ITEM_STATUS_FAILED",
-	}
-	BaristaOrderItemStatus_value = map[string]int32{
-		"BARISTA_ORDER_ITEM_STATUS_PENDING":   0,
-		"BARISTA_ORDER_ITEM_STATUS_STARTED":   1,
-		"BARISTA_ORDER_ITEM_STATUS_COMPLETED": 2,
-		"BARISTA_ORDER_ITEM_STATUS_FAILED":    3,
+	OrderPaymentStatus_name = map[int32]string{
+		0: "ORDER_PAYMENT_STATUS_PENDING",
+		1: "ORDER_PAYMENT_STATUS_PAID",
+		2: "ORDER_PAYMENT_STATUS_FAILED",
+		3: "ORDER_PAYMENT_STATUS_REFUNDED",
+		4: "ORDER_PAYMENT_STATUS_AUTHORIZED",
+		5: "ORDER_PAYMENT_STATUS_VOIDED",
+	}
+	OrderPaymentStatus_value = map[string]int32{
+		"ORDER_PAYMENT_STATUS_PENDING":    0,
+		"ORDER_PAYMENT_STATUS_PAID":       1,
+		"ORDER_PAYMENT_STATUS_FAILED":     2,
+		"ORDER_PAYMENT_STATUS_REFUNDED":   3,
+		"ORDER_PAYMENT_STATUS_AUTHORIZED": 4,
+		"ORDER_PAYMENT_STATUS_VOIDED":     5,
 	}
 )
 
-func (x BaristaOrderItemStatus) Enum() *BaristaOrderItemStatus {
-	p := new(BaristaOrderItemStatus)
+func (x OrderPaymentStatus) Enum() *OrderPaymentStatus {
+	p := new(OrderPaymentStatus)
 	*p = x
 	return p
 }
 
-func (x BaristaOrderItemStatus) String() string {
+func (x OrderPaymentStatus) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (BaristaOrderItemStatus) Descriptor() protoreflect.EnumDescriptor {
+func (OrderPaymentStatus) Descriptor() protoreflect.EnumDescriptor {
 	return file_cafe_proto_enumTypes[2].Descriptor()
 }
 
-func (BaristaOrderItemStatus) Type() protoreflect.EnumType {
+func (OrderPaymentStatus) Type() protoreflect.EnumType {
 	return &file_cafe_proto_enumTypes[2]
 }
 
-func (x BaristaOrderItemStatus) Number() protoreflect.EnumNumber {
+func (x OrderPaymentStatus) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use BaristaOrderItemStatus.Descriptor instead.
-func (BaristaOrderItemStatus) EnumDescriptor() ([]byte, []int) {
+// Deprecated: Use OrderPaymentStatus.Descriptor instead.
+func (OrderPaymentStatus) EnumDescriptor() ([]byte, []int) {
 	return file_cafe_proto_rawDescGZIP(), []int{2}
 }
 
+type OrderLoyaltyStatus int32
+
+const (
+	OrderLoyaltyStatus_ORDER_LOYALTY_STATUS_PENDING  OrderLoyaltyStatus = 0
+	OrderLoyaltyStatus_ORDER_LOYALTY_STATUS_SKIPPED  OrderLoyaltyStatus = 1
+	OrderLoyaltyStatus_ORDER_LOYALTY_STATUS_CREDITED OrderLoyaltyStatus = 2
+	OrderLoyaltyStatus_ORDER_LOYALTY_STATUS_FAILED   OrderLoyaltyStatus = 3
+)
+
+// Enum value maps for OrderLoyaltyStatus.
+var (
+	OrderLoyaltyStatus_name = map[int32]string{
+		0: "ORDER_LOYALTY_STATUS_PENDING",
+		1: "ORDER_LOYALTY_STATUS_SKIPPED",
+		2: "ORDER_LOYALTY_STATUS_CREDITED",
+		3: "ORDER_LOYALTY_STATUS_FAILED",
+	}
+	OrderLoyaltyStatus_value = map[string]int32{
+		"ORDER_LOYALTY_STATUS_PENDING":  0,
+		"ORDER_LOYALTY_STATUS_SKIPPED":  1,
+		"ORDER_LOYALTY_STATUS_CREDITED": 2,
+		"ORDER_LOYALTY_STATUS_FAILED":   3,
+	}
+)
+
+func (x OrderLoyaltyStatus) Enum() *OrderLoyaltyStatus {
+	p := new(OrderLoyaltyStatus)
+	*p = x
+	return p
+}
+
+func (x OrderLoyaltyStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderLoyaltyStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_cafe_proto_enumTypes[3].Descriptor()
+}
+
+func (OrderLoyaltyStatus) Type() protoreflect.EnumType {
+	return &file_cafe_proto_enumTypes[3]
+}
+
+func (x OrderLoyaltyStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderLoyaltyStatus.Descriptor instead.
+func (OrderLoyaltyStatus) EnumDescriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{3}
+}
+
+type StationOrderItemStatus int32
+
+const (
+	StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_PENDING   StationOrderItemStatus = 0
+	StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_STARTED   StationOrderItemStatus = 1
+	StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED StationOrderItemStatus = 2
+	StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_FAILED    StationOrderItemStatus = 3
+	StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_CANCELLED StationOrderItemStatus = 4
+	// The item failed and a remake was added to the order.
+	StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_REMADE StationOrderItemStatus = 5
+)
+
+// Enum value maps for StationOrderItemStatus.
+var (
+	StationOrderItemStatus_name = map[int32]string{
+		0: "STATION_ORDER_ITEM_STATUS_PENDING",
+		1: "STATION_ORDER_ITEM_STATUS_STARTED",
+		2: "STATION_ORDER_ITEM_STATUS_COMPLETED",
+		3: "STATION_ORDER_ITEM_STATUS_FAILED",
+		4: "STATION_ORDER_ITEM_STATUS_CANCELLED",
+		5: "STATION_ORDER_ITEM_STATUS_REMADE",
+	}
+	StationOrderItemStatus_value = map[string]int32{
+		"STATION_ORDER_ITEM_STATUS_PENDING":   0,
+		"STATION_ORDER_ITEM_STATUS_STARTED":   1,
+		"STATION_ORDER_ITEM_STATUS_COMPLETED": 2,
+		"STATION_ORDER_ITEM_STATUS_FAILED":    3,
+		"STATION_ORDER_ITEM_STATUS_CANCELLED": 4,
+		"STATION_ORDER_ITEM_STATUS_REMADE":    5,
+	}
+)
+
+func (x StationOrderItemStatus) Enum() *StationOrderItemStatus {
+	p := new(StationOrderItemStatus)
+	*p = x
+	return p
+}
+
+func (x StationOrderItemStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StationOrderItemStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_cafe_proto_enumTypes[4].Descriptor()
+}
+
+func (StationOrderItemStatus) Type() protoreflect.EnumType {
+	return &file_cafe_proto_enumTypes[4]
+}
+
+func (x StationOrderItemStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StationOrderItemStatus.Descriptor instead.
+func (StationOrderItemStatus) EnumDescriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{4}
+}
+
+type CustomerLoyaltyTransactionType int32
+
+const (
+	CustomerLoyaltyTransactionType_CUSTOMER_LOYALTY_TRANSACTION_TYPE_UNKNOWN          CustomerLoyaltyTransactionType = 0
+	CustomerLoyaltyTransactionType_CUSTOMER_LOYALTY_TRANSACTION_TYPE_STARTING_BALANCE CustomerLoyaltyTransactionType = 1
+	CustomerLoyaltyTransactionType_CUSTOMER_LOYALTY_TRANSACTION_TYPE_EARNED           CustomerLoyaltyTransactionType = 2
+	CustomerLoyaltyTransactionType_CUSTOMER_LOYALTY_TRANSACTION_TYPE_REDEEMED         CustomerLoyaltyTransactionType = 3
+	CustomerLoyaltyTransactionType_CUSTOMER_LOYALTY_TRANSACTION_TYPE_RELEASED         CustomerLoyaltyTransactionType = 4
+	CustomerLoyaltyTransactionType_CUSTOMER_LOYALTY_TRANSACTION_TYPE_EXPIRED          CustomerLoyaltyTransactionType = 5
+	CustomerLoyaltyTransactionType_CUSTOMER_LOYALTY_TRANSACTION_TYPE_ADJUSTED         CustomerLoyaltyTransactionType = 6
+)
+
+// Enum value maps for CustomerLoyaltyTransactionType.
+var (
+	CustomerLoyaltyTransactionType_name = map[int32]string{
+		0: "CUSTOMER_LOYALTY_TRANSACTION_TYPE_UNKNOWN",
+		1: "CUSTOMER_LOYALTY_TRANSACTION_TYPE_STARTING_BALANCE",
+		2: "CUSTOMER_LOYALTY_TRANSACTION_TYPE_EARNED",
+		3: "CUSTOMER_LOYALTY_TRANSACTION_TYPE_REDEEMED",
+		4: "CUSTOMER_LOYALTY_TRANSACTION_TYPE_RELEASED",
+		5: "CUSTOMER_LOYALTY_TRANSACTION_TYPE_EXPIRED",
+		6: "CUSTOMER_LOYALTY_TRANSACTION_TYPE_ADJUSTED",
+	}
+	CustomerLoyaltyTransactionType_value = map[string]int32{
+		"CUSTOMER_LOYALTY_TRANSACTION_TYPE_UNKNOWN":          0,
+		"CUSTOMER_LOYALTY_TRANSACTION_TYPE_STARTING_BALANCE": 1,
+		"CUSTOMER_LOYALTY_TRANSACTION_TYPE_EARNED":           2,
+		"CUSTOMER_LOYALTY_TRANSACTION_TYPE_REDEEMED":         3,
+		"CUSTOMER_LOYALTY_TRANSACTION_TYPE_RELEASED":         4,
+		"CUSTOMER_LOYALTY_TRANSACTION_TYPE_EXPIRED":          5,
+		"CUSTOMER_LOYALTY_TRANSACTION_TYPE_ADJUSTED":         6,
+	}
+)
+
+func (x CustomerLoyaltyTransactionType) Enum() *CustomerLoyaltyTransactionType {
+	p := new(CustomerLoyaltyTransactionType)
+	*p = x
+	return p
+}
+
+func (x CustomerLoyaltyTransactionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CustomerLoyaltyTransactionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_cafe_proto_enumTypes[5].Descriptor()
+}
+
+func (CustomerLoyaltyTransactionType) Type() protoreflect.EnumType {
+	return &file_cafe_proto_enumTypes[5]
+}
+
+func (x CustomerLoyaltyTransactionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CustomerLoyaltyTransactionType.Descriptor instead.
+func (CustomerLoyaltyTransactionType) EnumDescriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{5}
+}
+
 type Menu struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -226,9 +406,12 @@ type MenuItem struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type  ProductType `protobuf:"varint,1,opt,name=type,proto3,enum=temporalio.cafe.ProductType" json:"type,omitempty"`
-	Name  string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price uint32      `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Type           ProductType          `protobuf:"varint,1,opt,name=type,proto3,enum=temporalio.cafe.ProductType" json:"type,omitempty"`
+	Name           string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price          uint32               `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Category       string               `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Available      bool                 `protobuf:"varint,5,opt,name=available,proto3" json:"available,omitempty"`
+	ModifierGroups []*MenuModifierGroup `protobuf:"bytes,6,rep,name=modifier_groups,json=modifierGroups,proto3" json:"modifier_groups,omitempty"`
 }
 
 func (x *MenuItem) Reset() {
@@ -284,19 +467,40 @@ func (x *MenuItem) GetPrice() uint32 {
 	return 0
 }
 
-type OrderLineItem struct {
+func (x *MenuItem) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *MenuItem) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *MenuItem) GetModifierGroups() []*MenuModifierGroup {
+	if x != nil {
+		return x.ModifierGroups
+	}
+	return nil
+}
+
+type MenuModifierGroup struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type  ProductType `protobuf:"varint,1,opt,name=type,proto3,enum=temporalio.cafe.ProductType" json:"type,omitempty"`
-	Name  string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price uint32      `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
-	Count uint32      `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Name      string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Min       uint32          `protobuf:"varint,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max       uint32          `protobuf:"varint,3,opt,name=max,proto3" json:"max,omitempty"`
+	Modifiers []*MenuModifier `protobuf:"bytes,4,rep,name=modifiers,proto3" json:"modifiers,omitempty"`
 }
 
-func (x *OrderLineItem) Reset() {
-	*x = OrderLineItem{}
+func (x *MenuModifierGroup) Reset() {
+	*x = MenuModifierGroup{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cafe_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -304,13 +508,13 @@ func (x *OrderLineItem) Reset() {
 	}
 }
 
-func (x *OrderLineItem) String() string {
+func (x *MenuModifierGroup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderLineItem) ProtoMessage() {}
+func (*MenuModifierGroup) ProtoMessage() {}
 
-func (x *OrderLineItem) ProtoReflect() protoreflect.Message {
+func (x *MenuModifierGroup) ProtoReflect() protoreflect.Message {
 	mi := &file_cafe_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -322,52 +526,50 @@ func (x *OrderLineItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderLineItem.ProtoReflect.Descriptor instead.
-func (*OrderLineItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use MenuModifierGroup.ProtoReflect.Descriptor instead.
+func (*MenuModifierGroup) Descriptor() ([]byte, []int) {
 	return file_cafe_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *OrderLineItem) GetType() ProductType {
+func (x *MenuModifierGroup) GetName() string {
 	if x != nil {
-		return x.Type
+		return x.Name
 	}
-	return ProductType_PRODUCT_TYPE_UNKNOWN
+	return ""
 }
 
-func (x *OrderLineItem) GetName() string {
+func (x *MenuModifierGroup) GetMin() uint32 {
 	if x != nil {
-		return x.Name
+		return x.Min
 	}
-	return ""
+	return 0
 }
 
-func (x *OrderLineItem) GetPrice() uint32 {
+func (x *MenuModifierGroup) GetMax() uint32 {
 	if x != nil {
-		return x.Price
+		return x.Max
 	}
 	return 0
 }
 
-func (x *OrderLineItem) GetCount() uint32 {
+func (x *MenuModifierGroup) GetModifiers() []*MenuModifier {
 	if x != nil {
-		return x.Count
+		return x.Modifiers
 	}
-	return 0
+	return nil
 }
 
-type OrderInput struct {
+type MenuModifier struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email        string           `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Name         string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	PaymentToken string           `protobuf:"bytes,3,opt,name=payment_token,json=paymentToken,proto3" json:"payment_token,omitempty"`
-	Items        []*OrderLineItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PriceDelta int32  `protobuf:"varint,2,opt,name=price_delta,json=priceDelta,proto3" json:"price_delta,omitempty"`
 }
 
-func (x *OrderInput) Reset() {
-	*x = OrderInput{}
+func (x *MenuModifier) Reset() {
+	*x = MenuModifier{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cafe_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -375,13 +577,13 @@ func (x *OrderInput) Reset() {
 	}
 }
 
-func (x *OrderInput) String() string {
+func (x *MenuModifier) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderInput) ProtoMessage() {}
+func (*MenuModifier) ProtoMessage() {}
 
-func (x *OrderInput) ProtoReflect() protoreflect.Message {
+func (x *MenuModifier) ProtoReflect() protoreflect.Message {
 	mi := &file_cafe_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -393,47 +595,35 @@ func (x *OrderInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderInput.ProtoReflect.Descriptor instead.
-func (*OrderInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use MenuModifier.ProtoReflect.Descriptor instead.
+func (*MenuModifier) Descriptor() ([]byte, []int) {
 	return file_cafe_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *OrderInput) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
-func (x *OrderInput) GetName() string {
+func (x *MenuModifier) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *OrderInput) GetPaymentToken() string {
-	if x != nil {
-		return x.PaymentToken
-	}
-	return ""
-}
-
-func (x *OrderInput) GetItems() []*OrderLineItem {
+func (x *MenuModifier) GetPriceDelta() int32 {
 	if x != nil {
-		return x.Items
+		return x.PriceDelta
 	}
-	return nil
+	return 0
 }
 
-type OrderResult struct {
+type MenuItemRemoveInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *OrderResult) Reset() {
-	*x = OrderResult{}
+func (x *MenuItemRemoveInput) Reset() {
+	*x = MenuItemRemoveInput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cafe_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -441,13 +631,13 @@ func (x *OrderResult) Reset() {
 	}
 }
 
-func (x *OrderResult) String() string {
+func (x *MenuItemRemoveInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderResult) ProtoMessage() {}
+func (*MenuItemRemoveInput) ProtoMessage() {}
 
-func (x *OrderResult) ProtoReflect() protoreflect.Message {
+func (x *MenuItemRemoveInput) ProtoReflect() protoreflect.Message {
 	mi := &file_cafe_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -459,22 +649,29 @@ func (x *OrderResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderResult.ProtoReflect.Descriptor instead.
-func (*OrderResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use MenuItemRemoveInput.ProtoReflect.Descriptor instead.
+func (*MenuItemRemoveInput) Descriptor() ([]byte, []int) {
 	return file_cafe_proto_rawDescGZIP(), []int{4}
 }
 
-type KitchenOrderLineItem struct {
+func (x *MenuItemRemoveInput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type MenuItemAvailabilityInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Status KitchenOrderItemStatus `protobuf:"varint,2,opt,name=status,proto3,enum=temporalio.cafe.KitchenOrderItemStatus" json:"status,omitempty"`
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Available bool   `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
 }
 
-func (x *KitchenOrderLineItem) Reset() {
-	*x = KitchenOrderLineItem{}
+func (x *MenuItemAvailabilityInput) Reset() {
+	*x = MenuItemAvailabilityInput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cafe_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -482,13 +679,13 @@ func (x *KitchenOrderLineItem) Reset() {
 	}
 }
 
-func (x *KitchenOrderLineItem) String() string {
+func (x *MenuItemAvailabilityInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KitchenOrderLineItem) ProtoMessage() {}
+func (*MenuItemAvailabilityInput) ProtoMessage() {}
 
-func (x *KitchenOrderLineItem) ProtoReflect() protoreflect.Message {
+func (x *MenuItemAvailabilityInput) ProtoReflect() protoreflect.Message {
 	mi := &file_cafe_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -500,36 +697,41 @@ func (x *KitchenOrderLineItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KitchenOrderLineItem.ProtoReflect.Descriptor instead.
-func (*KitchenOrderLineItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use MenuItemAvailabilityInput.ProtoReflect.Descriptor instead.
+func (*MenuItemAvailabilityInput) Descriptor() ([]byte, []int) {
 	return file_cafe_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *KitchenOrderLineItem) GetName() string {
+func (x *MenuItemAvailabilityInput) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *KitchenOrderLineItem) GetStatus() KitchenOrderItemStatus {
+func (x *MenuItemAvailabilityInput) GetAvailable() bool {
 	if x != nil {
-		return x.Status
+		return x.Available
 	}
-	return KitchenOrderItemStatus_KITCHEN_ORDER_ITEM_STATUS_PENDING
+	return false
 }
 
-type KitchenOrderInput struct {
+type OrderLineItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Items []*OrderLineItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Type      ProductType              `protobuf:"varint,1,opt,name=type,proto3,enum=temporalio.cafe.ProductType" json:"type,omitempty"`
+	Name      string                   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price     uint32                   `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Count     uint32                   `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Modifiers []*OrderLineItemModifier `protobuf:"bytes,5,rep,name=modifiers,proto3" json:"modifiers,omitempty"`
+	// The station which makes the item, chosen by the station routing when the item was ordered.
+	Station string `protobuf:"bytes,6,opt,name=station,proto3" json:"station,omitempty"`
 }
 
-func (x *KitchenOrderInput) Reset() {
-	*x = KitchenOrderInput{}
+func (x *OrderLineItem) Reset() {
+	*x = OrderLineItem{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cafe_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -537,13 +739,13 @@ func (x *KitchenOrderInput) Reset() {
 	}
 }
 
-func (x *KitchenOrderInput) String() string {
+func (x *OrderLineItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KitchenOrderInput) ProtoMessage() {}
+func (*OrderLineItem) ProtoMessage() {}
 
-func (x *KitchenOrderInput) ProtoReflect() protoreflect.Message {
+func (x *OrderLineItem) ProtoReflect() protoreflect.Message {
 	mi := &file_cafe_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -555,36 +757,65 @@ func (x *KitchenOrderInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KitchenOrderInput.ProtoReflect.Descriptor instead.
-func (*KitchenOrderInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderLineItem.ProtoReflect.Descriptor instead.
+func (*OrderLineItem) Descriptor() ([]byte, []int) {
 	return file_cafe_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *KitchenOrderInput) GetName() string {
+func (x *OrderLineItem) GetType() ProductType {
+	if x != nil {
+		return x.Type
+	}
+	return ProductType_PRODUCT_TYPE_UNKNOWN
+}
+
+func (x *OrderLineItem) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *KitchenOrderInput) GetItems() []*OrderLineItem {
+func (x *OrderLineItem) GetPrice() uint32 {
 	if x != nil {
-		return x.Items
+		return x.Price
+	}
+	return 0
+}
+
+func (x *OrderLineItem) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *OrderLineItem) GetModifiers() []*OrderLineItemModifier {
+	if x != nil {
+		return x.Modifiers
 	}
 	return nil
 }
 
-type KitchenOrderItemStatusUpdate struct {
+func (x *OrderLineItem) GetStation() string {
+	if x != nil {
+		return x.Station
+	}
+	return ""
+}
+
+type OrderLineItemModifier struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Line   uint32                 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
-	Status KitchenOrderItemStatus `protobuf:"varint,2,opt,name=status,proto3,enum=temporalio.cafe.KitchenOrderItemStatus" json:"status,omitempty"`
+	Group      string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PriceDelta int32  `protobuf:"varint,3,opt,name=price_delta,json=priceDelta,proto3" json:"price_delta,omitempty"`
 }
 
-func (x *KitchenOrderItemStatusUpdate) Reset() {
-	*x = KitchenOrderItemStatusUpdate{}
+func (x *OrderLineItemModifier) Reset() {
+	*x = OrderLineItemModifier{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cafe_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -592,13 +823,13 @@ func (x *KitchenOrderItemStatusUpdate) Reset() {
 	}
 }
 
-func (x *KitchenOrderItemStatusUpdate) String() string {
+func (x *OrderLineItemModifier) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KitchenOrderItemStatusUpdate) ProtoMessage() {}
+func (*OrderLineItemModifier) ProtoMessage() {}
 
-func (x *KitchenOrderItemStatusUpdate) ProtoReflect() protoreflect.Message {
+func (x *OrderLineItemModifier) ProtoReflect() protoreflect.Message {
 	mi := &file_cafe_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -610,37 +841,51 @@ func (x *KitchenOrderItemStatusUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KitchenOrderItemStatusUpdate.ProtoReflect.Descriptor instead.
-func (*KitchenOrderItemStatusUpdate) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderLineItemModifier.ProtoReflect.Descriptor instead.
+func (*OrderLineItemModifier) Descriptor() ([]byte, []int) {
 	return file_cafe_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *KitchenOrderItemStatusUpdate) GetLine() uint32 {
+func (x *OrderLineItemModifier) GetGroup() string {
 	if x != nil {
-		return x.Line
+		return x.Group
 	}
-	return 0
+	return ""
 }
 
-func (x *KitchenOrderItemStatusUpdate) GetStatus() KitchenOrderItemStatus {
+func (x *OrderLineItemModifier) GetName() string {
 	if x != nil {
-		return x.Status
+		return x.Name
 	}
-	return KitchenOrderItemStatus_KITCHEN_ORDER_ITEM_STATUS_PENDING
+	return ""
+}
+
+func (x *OrderLineItemModifier) GetPriceDelta() int32 {
+	if x != nil {
+		return x.PriceDelta
+	}
+	return 0
 }
 
-type KitchenOrderStatus struct {
+type OrderInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Open  bool                    `protobuf:"varint,2,opt,name=open,proto3" json:"open,omitempty"`
-	Items []*KitchenOrderLineItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Email        string           `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Name         string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PaymentToken string           `protobuf:"bytes,3,opt,name=payment_token,json=paymentToken,proto3" json:"payment_token,omitempty"`
+	Items        []*OrderLineItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	// Gift card number to pay from before the payment token is charged.
+	GiftCard string `protobuf:"bytes,5,opt,name=gift_card,json=giftCard,proto3" json:"gift_card,omitempty"`
+	// Ways the order is paid for, charged in turn. If set, payment_token and gift_card are ignored.
+	Tenders []*Tender `protobuf:"bytes,6,rep,name=tenders,proto3" json:"tenders,omitempty"`
+	// Times a station remakes a failed item before it is refunded.
+	MaxRemakes uint32 `protobuf:"varint,7,opt,name=max_remakes,json=maxRemakes,proto3" json:"max_remakes,omitempty"`
 }
 
-func (x *KitchenOrderStatus) Reset() {
-	*x = KitchenOrderStatus{}
+func (x *OrderInput) Reset() {
+	*x = OrderInput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cafe_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -648,13 +893,13 @@ func (x *KitchenOrderStatus) Reset() {
 	}
 }
 
-func (x *KitchenOrderStatus) String() string {
+func (x *OrderInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KitchenOrderStatus) ProtoMessage() {}
+func (*OrderInput) ProtoMessage() {}
 
-func (x *KitchenOrderStatus) ProtoReflect() protoreflect.Message {
+func (x *OrderInput) ProtoReflect() protoreflect.Message {
 	mi := &file_cafe_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -666,40 +911,75 @@ func (x *KitchenOrderStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KitchenOrderStatus.ProtoReflect.Descriptor instead.
-func (*KitchenOrderStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderInput.ProtoReflect.Descriptor instead.
+func (*OrderInput) Descriptor() ([]byte, []int) {
 	return file_cafe_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *KitchenOrderStatus) GetName() string {
+func (x *OrderInput) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *OrderInput) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *KitchenOrderStatus) GetOpen() bool {
+func (x *OrderInput) GetPaymentToken() string {
 	if x != nil {
-		return x.Open
+		return x.PaymentToken
 	}
-	return false
+	return ""
 }
 
-func (x *KitchenOrderStatus) GetItems() []*KitchenOrderLineItem {
+func (x *OrderInput) GetItems() []*OrderLineItem {
 	if x != nil {
 		return x.Items
 	}
 	return nil
 }
 
-type KitchenOrderResult struct {
+func (x *OrderInput) GetGiftCard() string {
+	if x != nil {
+		return x.GiftCard
+	}
+	return ""
+}
+
+func (x *OrderInput) GetTenders() []*Tender {
+	if x != nil {
+		return x.Tenders
+	}
+	return nil
+}
+
+func (x *OrderInput) GetMaxRemakes() uint32 {
+	if x != nil {
+		return x.MaxRemakes
+	}
+	return 0
+}
+
+type Tender struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Type TenderType `protobuf:"varint,1,opt,name=type,proto3,enum=temporalio.cafe.TenderType" json:"type,omitempty"`
+	// Payment token for a card, the gift card number, or the customer's email for loyalty points.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// Amount to take from the tender. Zero takes whatever is still owed, gift cards are
+	// limited to their balance.
+	Amount uint32 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *KitchenOrderResult) Reset() {
-	*x = KitchenOrderResult{}
+func (x *Tender) Reset() {
+	*x = Tender{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cafe_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -707,13 +987,13 @@ func (x *KitchenOrderResult) Reset() {
 	}
 }
 
-func (x *KitchenOrderResult) String() string {
+func (x *Tender) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KitchenOrderResult) ProtoMessage() {}
+func (*Tender) ProtoMessage() {}
 
-func (x *KitchenOrderResult) ProtoReflect() protoreflect.Message {
+func (x *Tender) ProtoReflect() protoreflect.Message {
 	mi := &file_cafe_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -725,22 +1005,46 @@ func (x *KitchenOrderResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KitchenOrderResult.ProtoReflect.Descriptor instead.
-func (*KitchenOrderResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use Tender.ProtoReflect.Descriptor instead.
+func (*Tender) Descriptor() ([]byte, []int) {
 	return file_cafe_proto_rawDescGZIP(), []int{9}
 }
 
-type BaristaOrderLineItem struct {
+func (x *Tender) GetType() TenderType {
+	if x != nil {
+		return x.Type
+	}
+	return TenderType_TENDER_TYPE_UNKNOWN
+}
+
+func (x *Tender) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *Tender) GetAmount() uint32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type OrderTenderStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Status BaristaOrderItemStatus `protobuf:"varint,2,opt,name=status,proto3,enum=temporalio.cafe.BaristaOrderItemStatus" json:"status,omitempty"`
+	Type TenderType `protobuf:"varint,1,opt,name=type,proto3,enum=temporalio.cafe.TenderType" json:"type,omitempty"`
+	// Amount taken from the tender.
+	Amount uint32 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Amount given back to the tender, because items were not made or the order failed.
+	Refunded uint32 `protobuf:"varint,3,opt,name=refunded,proto3" json:"refunded,omitempty"`
 }
 
-func (x *BaristaOrderLineItem) Reset() {
-	*x = BaristaOrderLineItem{}
+func (x *OrderTenderStatus) Reset() {
+	*x = OrderTenderStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cafe_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -748,13 +1052,13 @@ func (x *BaristaOrderLineItem) Reset() {
 	}
 }
 
-func (x *BaristaOrderLineItem) String() string {
+func (x *OrderTenderStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BaristaOrderLineItem) ProtoMessage() {}
+func (*OrderTenderStatus) ProtoMessage() {}
 
-func (x *BaristaOrderLineItem) ProtoReflect() protoreflect.Message {
+func (x *OrderTenderStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_cafe_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -766,36 +1070,40 @@ func (x *BaristaOrderLineItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BaristaOrderLineItem.ProtoReflect.Descriptor instead.
-func (*BaristaOrderLineItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderTenderStatus.ProtoReflect.Descriptor instead.
+func (*OrderTenderStatus) Descriptor() ([]byte, []int) {
 	return file_cafe_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *BaristaOrderLineItem) GetName() string {
+func (x *OrderTenderStatus) GetType() TenderType {
 	if x != nil {
-		return x.Name
+		return x.Type
 	}
-	return ""
+	return TenderType_TENDER_TYPE_UNKNOWN
 }
 
-func (x *BaristaOrderLineItem) GetStatus() BaristaOrderItemStatus {
+func (x *OrderTenderStatus) GetAmount() uint32 {
 	if x != nil {
-		return x.Status
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *OrderTenderStatus) GetRefunded() uint32 {
+	if x != nil {
+		return x.Refunded
 	}
-	return BaristaOrderItemStatus_BARISTA_ORDER_ITEM_STATUS_PENDING
+	return 0
 }
 
-type BaristaOrderInput struct {
+type OrderResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Name  string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Items []*OrderLineItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *BaristaOrderInput) Reset() {
-	*x = BaristaOrderInput{}
+func (x *OrderResult) Reset() {
+	*x = OrderResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cafe_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -803,13 +1111,13 @@ func (x *BaristaOrderInput) Reset() {
 	}
 }
 
-func (x *BaristaOrderInput) String() string {
+func (x *OrderResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BaristaOrderInput) ProtoMessage() {}
+func (*OrderResult) ProtoMessage() {}
 
-func (x *BaristaOrderInput) ProtoReflect() protoreflect.Message {
+func (x *OrderResult) ProtoReflect() protoreflect.Message {
 	mi := &file_cafe_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -821,36 +1129,40 @@ func (x *BaristaOrderInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BaristaOrderInput.ProtoReflect.Descriptor instead.
-func (*BaristaOrderInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderResult.ProtoReflect.Descriptor instead.
+func (*OrderResult) Descriptor() ([]byte, []int) {
 	return file_cafe_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *BaristaOrderInput) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *BaristaOrderInput) GetItems() []*OrderLineItem {
-	if x != nil {
-		return x.Items
-	}
-	return nil
-}
-
-type BaristaOrderItemStatusUpdate struct {
+type OrderStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Line   uint32                 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
-	Status BaristaOrderItemStatus `protobuf:"varint,2,opt,name=status,proto3,enum=temporalio.cafe.BaristaOrderItemStatus" json:"status,omitempty"`
-}
-
-func (x *BaristaOrderItemStatusUpdate) Reset() {
-	*x = BaristaOrderItemStatusUpdate{}
+	Name              string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Open              bool               `protobuf:"varint,2,opt,name=open,proto3" json:"open,omitempty"`
+	Payment           OrderPaymentStatus `protobuf:"varint,3,opt,name=payment,proto3,enum=temporalio.cafe.OrderPaymentStatus" json:"payment,omitempty"`
+	FulfilmentExpired bool               `protobuf:"varint,6,opt,name=fulfilment_expired,json=fulfilmentExpired,proto3" json:"fulfilment_expired,omitempty"`
+	Loyalty           OrderLoyaltyStatus `protobuf:"varint,7,opt,name=loyalty,proto3,enum=temporalio.cafe.OrderLoyaltyStatus" json:"loyalty,omitempty"`
+	LoyaltyPoints     uint32             `protobuf:"varint,8,opt,name=loyalty_points,json=loyaltyPoints,proto3" json:"loyalty_points,omitempty"`
+	Error             string             `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	Cancelled         bool               `protobuf:"varint,10,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	Items             []*OrderLineItem   `protobuf:"bytes,11,rep,name=items,proto3" json:"items,omitempty"`
+	Total             uint32             `protobuf:"varint,12,opt,name=total,proto3" json:"total,omitempty"`
+	Refunded          uint32             `protobuf:"varint,13,opt,name=refunded,proto3" json:"refunded,omitempty"`
+	// Items which a station could not make, and so were not charged for.
+	Refunds []*OrderLineItem     `protobuf:"bytes,14,rep,name=refunds,proto3" json:"refunds,omitempty"`
+	Tenders []*OrderTenderStatus `protobuf:"bytes,15,rep,name=tenders,proto3" json:"tenders,omitempty"`
+	// Why the customer's loyalty points could not be credited.
+	LoyaltyError string `protobuf:"bytes,16,opt,name=loyalty_error,json=loyaltyError,proto3" json:"loyalty_error,omitempty"`
+	// The customer asked to be forgotten, so their name and email have been removed from the order.
+	CustomerForgotten bool `protobuf:"varint,17,opt,name=customer_forgotten,json=customerForgotten,proto3" json:"customer_forgotten,omitempty"`
+	// The latest status reported by each station making items for the order.
+	Stations map[string]*StationOrderStatus `protobuf:"bytes,18,rep,name=stations,proto3" json:"stations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *OrderStatus) Reset() {
+	*x = OrderStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cafe_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -858,13 +1170,13 @@ func (x *BaristaOrderItemStatusUpdate) Reset() {
 	}
 }
 
-func (x *BaristaOrderItemStatusUpdate) String() string {
+func (x *OrderStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BaristaOrderItemStatusUpdate) ProtoMessage() {}
+func (*OrderStatus) ProtoMessage() {}
 
-func (x *BaristaOrderItemStatusUpdate) ProtoReflect() protoreflect.Message {
+func (x *OrderStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_cafe_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -876,37 +1188,131 @@ func (x *BaristaOrderItemStatusUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BaristaOrderItemStatusUpdate.ProtoReflect.Descriptor instead.
-func (*BaristaOrderItemStatusUpdate) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderStatus.ProtoReflect.Descriptor instead.
+func (*OrderStatus) Descriptor() ([]byte, []int) {
 	return file_cafe_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *BaristaOrderItemStatusUpdate) GetLine() uint32 {
+func (x *OrderStatus) GetName() string {
 	if x != nil {
-		return x.Line
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OrderStatus) GetOpen() bool {
+	if x != nil {
+		return x.Open
+	}
+	return false
+}
+
+func (x *OrderStatus) GetPayment() OrderPaymentStatus {
+	if x != nil {
+		return x.Payment
+	}
+	return OrderPaymentStatus_ORDER_PAYMENT_STATUS_PENDING
+}
+
+func (x *OrderStatus) GetFulfilmentExpired() bool {
+	if x != nil {
+		return x.FulfilmentExpired
+	}
+	return false
+}
+
+func (x *OrderStatus) GetLoyalty() OrderLoyaltyStatus {
+	if x != nil {
+		return x.Loyalty
+	}
+	return OrderLoyaltyStatus_ORDER_LOYALTY_STATUS_PENDING
+}
+
+func (x *OrderStatus) GetLoyaltyPoints() uint32 {
+	if x != nil {
+		return x.LoyaltyPoints
 	}
 	return 0
 }
 
-func (x *BaristaOrderItemStatusUpdate) GetStatus() BaristaOrderItemStatus {
+func (x *OrderStatus) GetError() string {
 	if x != nil {
-		return x.Status
+		return x.Error
+	}
+	return ""
+}
+
+func (x *OrderStatus) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+func (x *OrderStatus) GetItems() []*OrderLineItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *OrderStatus) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *OrderStatus) GetRefunded() uint32 {
+	if x != nil {
+		return x.Refunded
+	}
+	return 0
+}
+
+func (x *OrderStatus) GetRefunds() []*OrderLineItem {
+	if x != nil {
+		return x.Refunds
+	}
+	return nil
+}
+
+func (x *OrderStatus) GetTenders() []*OrderTenderStatus {
+	if x != nil {
+		return x.Tenders
+	}
+	return nil
+}
+
+func (x *OrderStatus) GetLoyaltyError() string {
+	if x != nil {
+		return x.LoyaltyError
+	}
+	return ""
+}
+
+func (x *OrderStatus) GetCustomerForgotten() bool {
+	if x != nil {
+		return x.CustomerForgotten
+	}
+	return false
+}
+
+func (x *OrderStatus) GetStations() map[string]*StationOrderStatus {
+	if x != nil {
+		return x.Stations
 	}
-	return BaristaOrderItemStatus_BARISTA_ORDER_ITEM_STATUS_PENDING
+	return nil
 }
 
-type BaristaOrderStatus struct {
+type OrderCancelInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Name  string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Open  bool                    `protobuf:"varint,2,opt,name=open,proto3" json:"open,omitempty"`
-	Items []*BaristaOrderLineItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *BaristaOrderStatus) Reset() {
-	*x = BaristaOrderStatus{}
+func (x *OrderCancelInput) Reset() {
+	*x = OrderCancelInput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cafe_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -914,13 +1320,13 @@ func (x *BaristaOrderStatus) Reset() {
 	}
 }
 
-func (x *BaristaOrderStatus) String() string {
+func (x *OrderCancelInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BaristaOrderStatus) ProtoMessage() {}
+func (*OrderCancelInput) ProtoMessage() {}
 
-func (x *BaristaOrderStatus) ProtoReflect() protoreflect.Message {
+func (x *OrderCancelInput) ProtoReflect() protoreflect.Message {
 	mi := &file_cafe_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -932,40 +1338,22 @@ func (x *BaristaOrderStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BaristaOrderStatus.ProtoReflect.Descriptor instead.
-func (*BaristaOrderStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderCancelInput.ProtoReflect.Descriptor instead.
+func (*OrderCancelInput) Descriptor() ([]byte, []int) {
 	return file_cafe_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *BaristaOrderStatus) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *BaristaOrderStatus) GetOpen() bool {
-	if x != nil {
-		return x.Open
-	}
-	return false
-}
-
-func (x *BaristaOrderStatus) GetItems() []*BaristaOrderLineItem {
-	if x != nil {
-		return x.Items
-	}
-	return nil
-}
-
-type BaristaOrderResult struct {
+type OrderModifyInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Add    []*OrderLineItem `protobuf:"bytes,1,rep,name=add,proto3" json:"add,omitempty"`
+	Remove []*OrderLineItem `protobuf:"bytes,2,rep,name=remove,proto3" json:"remove,omitempty"`
 }
 
-func (x *BaristaOrderResult) Reset() {
-	*x = BaristaOrderResult{}
+func (x *OrderModifyInput) Reset() {
+	*x = OrderModifyInput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cafe_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -973,13 +1361,13 @@ func (x *BaristaOrderResult) Reset() {
 	}
 }
 
-func (x *BaristaOrderResult) String() string {
+func (x *OrderModifyInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BaristaOrderResult) ProtoMessage() {}
+func (*OrderModifyInput) ProtoMessage() {}
 
-func (x *BaristaOrderResult) ProtoReflect() protoreflect.Message {
+func (x *OrderModifyInput) ProtoReflect() protoreflect.Message {
 	mi := &file_cafe_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -991,21 +1379,35 @@ func (x *BaristaOrderResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BaristaOrderResult.ProtoReflect.Descriptor instead.
-func (*BaristaOrderResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderModifyInput.ProtoReflect.Descriptor instead.
+func (*OrderModifyInput) Descriptor() ([]byte, []int) {
 	return file_cafe_proto_rawDescGZIP(), []int{14}
 }
 
-type CustomerLoyaltyPointsBalance struct {
+func (x *OrderModifyInput) GetAdd() []*OrderLineItem {
+	if x != nil {
+		return x.Add
+	}
+	return nil
+}
+
+func (x *OrderModifyInput) GetRemove() []*OrderLineItem {
+	if x != nil {
+		return x.Remove
+	}
+	return nil
+}
+
+type OrderSequenceNextInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Points uint32 `protobuf:"varint,1,opt,name=points,proto3" json:"points,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,1,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
-func (x *CustomerLoyaltyPointsBalance) Reset() {
-	*x = CustomerLoyaltyPointsBalance{}
+func (x *OrderSequenceNextInput) Reset() {
+	*x = OrderSequenceNextInput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cafe_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1013,13 +1415,13 @@ func (x *CustomerLoyaltyPointsBalance) Reset() {
 	}
 }
 
-func (x *CustomerLoyaltyPointsBalance) String() string {
+func (x *OrderSequenceNextInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CustomerLoyaltyPointsBalance) ProtoMessage() {}
+func (*OrderSequenceNextInput) ProtoMessage() {}
 
-func (x *CustomerLoyaltyPointsBalance) ProtoReflect() protoreflect.Message {
+func (x *OrderSequenceNextInput) ProtoReflect() protoreflect.Message {
 	mi := &file_cafe_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1031,28 +1433,29 @@ func (x *CustomerLoyaltyPointsBalance) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CustomerLoyaltyPointsBalance.ProtoReflect.Descriptor instead.
-func (*CustomerLoyaltyPointsBalance) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderSequenceNextInput.ProtoReflect.Descriptor instead.
+func (*OrderSequenceNextInput) Descriptor() ([]byte, []int) {
 	return file_cafe_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *CustomerLoyaltyPointsBalance) GetPoints() uint32 {
+func (x *OrderSequenceNextInput) GetIdempotencyKey() string {
 	if x != nil {
-		return x.Points
+		return x.IdempotencyKey
 	}
-	return 0
+	return ""
 }
 
-type CustomerLoyaltyPointsEarned struct {
+type OrderSequenceNextResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Points uint32 `protobuf:"varint,1,opt,name=points,proto3" json:"points,omitempty"`
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Duplicate bool   `protobuf:"varint,2,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
 }
 
-func (x *CustomerLoyaltyPointsEarned) Reset() {
-	*x = CustomerLoyaltyPointsEarned{}
+func (x *OrderSequenceNextResult) Reset() {
+	*x = OrderSequenceNextResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cafe_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1060,13 +1463,13 @@ func (x *CustomerLoyaltyPointsEarned) Reset() {
 	}
 }
 
-func (x *CustomerLoyaltyPointsEarned) String() string {
+func (x *OrderSequenceNextResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CustomerLoyaltyPointsEarned) ProtoMessage() {}
+func (*OrderSequenceNextResult) ProtoMessage() {}
 
-func (x *CustomerLoyaltyPointsEarned) ProtoReflect() protoreflect.Message {
+func (x *OrderSequenceNextResult) ProtoReflect() protoreflect.Message {
 	mi := &file_cafe_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1078,28 +1481,41 @@ func (x *CustomerLoyaltyPointsEarned) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CustomerLoyaltyPointsEarned.ProtoReflect.Descriptor instead.
-func (*CustomerLoyaltyPointsEarned) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderSequenceNextResult.ProtoReflect.Descriptor instead.
+func (*OrderSequenceNextResult) Descriptor() ([]byte, []int) {
 	return file_cafe_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *CustomerLoyaltyPointsEarned) GetPoints() uint32 {
+func (x *OrderSequenceNextResult) GetId() string {
 	if x != nil {
-		return x.Points
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-type CustomerInput struct {
+func (x *OrderSequenceNextResult) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+// StationRoute sends the menu items it matches to a station. Fields which are not set match any item.
+type StationRoute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Station string      `protobuf:"bytes,1,opt,name=station,proto3" json:"station,omitempty"`
+	Type    ProductType `protobuf:"varint,2,opt,name=type,proto3,enum=temporalio.cafe.ProductType" json:"type,omitempty"`
+	// The menu category of the items.
+	Category string `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	// The names of the items.
+	Items []string `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *CustomerInput) Reset() {
-	*x = CustomerInput{}
+func (x *StationRoute) Reset() {
+	*x = StationRoute{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cafe_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1107,13 +1523,13 @@ func (x *CustomerInput) Reset() {
 	}
 }
 
-func (x *CustomerInput) String() string {
+func (x *StationRoute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CustomerInput) ProtoMessage() {}
+func (*StationRoute) ProtoMessage() {}
 
-func (x *CustomerInput) ProtoReflect() protoreflect.Message {
+func (x *StationRoute) ProtoReflect() protoreflect.Message {
 	mi := &file_cafe_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1125,28 +1541,53 @@ func (x *CustomerInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CustomerInput.ProtoReflect.Descriptor instead.
-func (*CustomerInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use StationRoute.ProtoReflect.Descriptor instead.
+func (*StationRoute) Descriptor() ([]byte, []int) {
 	return file_cafe_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *CustomerInput) GetEmail() string {
+func (x *StationRoute) GetStation() string {
 	if x != nil {
-		return x.Email
+		return x.Station
 	}
 	return ""
 }
 
-type Payment struct {
+func (x *StationRoute) GetType() ProductType {
+	if x != nil {
+		return x.Type
+	}
+	return ProductType_PRODUCT_TYPE_UNKNOWN
+}
+
+func (x *StationRoute) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *StationRoute) GetItems() []string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// StationRouting decides which station makes each item. The first route matching an item is used.
+type StationRouting struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Authcode string `protobuf:"bytes,1,opt,name=authcode,proto3" json:"authcode,omitempty"`
+	Routes []*StationRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	// Times a station remakes a failed item before it is refunded.
+	MaxRemakes uint32            `protobuf:"varint,2,opt,name=max_remakes,json=maxRemakes,proto3" json:"max_remakes,omitempty"`
+	Stations   []*StationOptions `protobuf:"bytes,3,rep,name=stations,proto3" json:"stations,omitempty"`
 }
 
-func (x *Payment) Reset() {
-	*x = Payment{}
+func (x *StationRouting) Reset() {
+	*x = StationRouting{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cafe_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1154,13 +1595,13 @@ func (x *Payment) Reset() {
 	}
 }
 
-func (x *Payment) String() string {
+func (x *StationRouting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Payment) ProtoMessage() {}
+func (*StationRouting) ProtoMessage() {}
 
-func (x *Payment) ProtoReflect() protoreflect.Message {
+func (x *StationRouting) ProtoReflect() protoreflect.Message {
 	mi := &file_cafe_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1172,28 +1613,47 @@ func (x *Payment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
-func (*Payment) Descriptor() ([]byte, []int) {
+// Deprecated: Use StationRouting.ProtoReflect.Descriptor instead.
+func (*StationRouting) Descriptor() ([]byte, []int) {
 	return file_cafe_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *Payment) GetAuthcode() string {
+func (x *StationRouting) GetRoutes() []*StationRoute {
 	if x != nil {
-		return x.Authcode
+		return x.Routes
 	}
-	return ""
+	return nil
+}
+
+func (x *StationRouting) GetMaxRemakes() uint32 {
+	if x != nil {
+		return x.MaxRemakes
+	}
+	return 0
 }
 
-type ProcessPaymentInput struct {
+func (x *StationRouting) GetStations() []*StationOptions {
+	if x != nil {
+		return x.Stations
+	}
+	return nil
+}
+
+// StationOptions configures how a station's queue lets orders through.
+type StationOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Station string `protobuf:"bytes,1,opt,name=station,proto3" json:"station,omitempty"`
+	// The most items the station makes at once.
+	Capacity uint32 `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// How long the station takes to make an item, used to estimate waits.
+	ItemTime *durationpb.Duration `protobuf:"bytes,3,opt,name=item_time,json=itemTime,proto3" json:"item_time,omitempty"`
 }
 
-func (x *ProcessPaymentInput) Reset() {
-	*x = ProcessPaymentInput{}
+func (x *StationOptions) Reset() {
+	*x = StationOptions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cafe_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1201,13 +1661,13 @@ func (x *ProcessPaymentInput) Reset() {
 	}
 }
 
-func (x *ProcessPaymentInput) String() string {
+func (x *StationOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessPaymentInput) ProtoMessage() {}
+func (*StationOptions) ProtoMessage() {}
 
-func (x *ProcessPaymentInput) ProtoReflect() protoreflect.Message {
+func (x *StationOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_cafe_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1219,28 +1679,49 @@ func (x *ProcessPaymentInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessPaymentInput.ProtoReflect.Descriptor instead.
-func (*ProcessPaymentInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use StationOptions.ProtoReflect.Descriptor instead.
+func (*StationOptions) Descriptor() ([]byte, []int) {
 	return file_cafe_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ProcessPaymentInput) GetToken() string {
+func (x *StationOptions) GetStation() string {
 	if x != nil {
-		return x.Token
+		return x.Station
 	}
 	return ""
 }
 
-type ProcessPaymentResult struct {
+func (x *StationOptions) GetCapacity() uint32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *StationOptions) GetItemTime() *durationpb.Duration {
+	if x != nil {
+		return x.ItemTime
+	}
+	return nil
+}
+
+type StationOrderLineItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Payment *Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status    StationOrderItemStatus `protobuf:"varint,2,opt,name=status,proto3,enum=temporalio.cafe.StationOrderItemStatus" json:"status,omitempty"`
+	Modifiers []string               `protobuf:"bytes,3,rep,name=modifiers,proto3" json:"modifiers,omitempty"`
+	// The line this item remakes, 0 if it isn't a remake.
+	RemakeOf     uint32 `protobuf:"varint,4,opt,name=remake_of,json=remakeOf,proto3" json:"remake_of,omitempty"`
+	RemakeReason string `protobuf:"bytes,5,opt,name=remake_reason,json=remakeReason,proto3" json:"remake_reason,omitempty"`
+	// How many times the item has been remade, counting this one.
+	Remakes uint32 `protobuf:"varint,6,opt,name=remakes,proto3" json:"remakes,omitempty"`
 }
 
-func (x *ProcessPaymentResult) Reset() {
-	*x = ProcessPaymentResult{}
+func (x *StationOrderLineItem) Reset() {
+	*x = StationOrderLineItem{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cafe_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1248,13 +1729,13 @@ func (x *ProcessPaymentResult) Reset() {
 	}
 }
 
-func (x *ProcessPaymentResult) String() string {
+func (x *StationOrderLineItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessPaymentResult) ProtoMessage() {}
+func (*StationOrderLineItem) ProtoMessage() {}
 
-func (x *ProcessPaymentResult) ProtoReflect() protoreflect.Message {
+func (x *StationOrderLineItem) ProtoReflect() protoreflect.Message {
 	mi := &file_cafe_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1266,28 +1747,70 @@ func (x *ProcessPaymentResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessPaymentResult.ProtoReflect.Descriptor instead.
-func (*ProcessPaymentResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use StationOrderLineItem.ProtoReflect.Descriptor instead.
+func (*StationOrderLineItem) Descriptor() ([]byte, []int) {
 	return file_cafe_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ProcessPaymentResult) GetPayment() *Payment {
+func (x *StationOrderLineItem) GetName() string {
 	if x != nil {
-		return x.Payment
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type ProcessPaymentRefundInput struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+func (x *StationOrderLineItem) GetStatus() StationOrderItemStatus {
+	if x != nil {
+		return x.Status
+	}
+	return StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_PENDING
+}
+
+func (x *StationOrderLineItem) GetModifiers() []string {
+	if x != nil {
+		return x.Modifiers
+	}
+	return nil
+}
+
+func (x *StationOrderLineItem) GetRemakeOf() uint32 {
+	if x != nil {
+		return x.RemakeOf
+	}
+	return 0
+}
+
+func (x *StationOrderLineItem) GetRemakeReason() string {
+	if x != nil {
+		return x.RemakeReason
+	}
+	return ""
+}
+
+func (x *StationOrderLineItem) GetRemakes() uint32 {
+	if x != nil {
+		return x.Remakes
+	}
+	return 0
+}
+
+type StationOrderInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Payment *Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	// The station making the items, such as kitchen or barista.
+	Station string           `protobuf:"bytes,1,opt,name=station,proto3" json:"station,omitempty"`
+	Name    string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Items   []*OrderLineItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	// Times a failed item is remade before it is reported as failed.
+	MaxRemakes uint32 `protobuf:"varint,4,opt,name=max_remakes,json=maxRemakes,proto3" json:"max_remakes,omitempty"`
+	// Orders with a higher priority are made first, such as staff orders or rushes.
+	Priority uint32 `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
-func (x *ProcessPaymentRefundInput) Reset() {
-	*x = ProcessPaymentRefundInput{}
+func (x *StationOrderInput) Reset() {
+	*x = StationOrderInput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cafe_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1295,13 +1818,13 @@ func (x *ProcessPaymentRefundInput) Reset() {
 	}
 }
 
-func (x *ProcessPaymentRefundInput) String() string {
+func (x *StationOrderInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessPaymentRefundInput) ProtoMessage() {}
+func (*StationOrderInput) ProtoMessage() {}
 
-func (x *ProcessPaymentRefundInput) ProtoReflect() protoreflect.Message {
+func (x *StationOrderInput) ProtoReflect() protoreflect.Message {
 	mi := &file_cafe_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1313,26 +1836,59 @@ func (x *ProcessPaymentRefundInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessPaymentRefundInput.ProtoReflect.Descriptor instead.
-func (*ProcessPaymentRefundInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use StationOrderInput.ProtoReflect.Descriptor instead.
+func (*StationOrderInput) Descriptor() ([]byte, []int) {
 	return file_cafe_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ProcessPaymentRefundInput) GetPayment() *Payment {
+func (x *StationOrderInput) GetStation() string {
 	if x != nil {
-		return x.Payment
+		return x.Station
+	}
+	return ""
+}
+
+func (x *StationOrderInput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StationOrderInput) GetItems() []*OrderLineItem {
+	if x != nil {
+		return x.Items
 	}
 	return nil
 }
 
-type ProcessPaymentRefundResult struct {
+func (x *StationOrderInput) GetMaxRemakes() uint32 {
+	if x != nil {
+		return x.MaxRemakes
+	}
+	return 0
+}
+
+func (x *StationOrderInput) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type StationOrderItemStatusInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Line   uint32                 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Status StationOrderItemStatus `protobuf:"varint,2,opt,name=status,proto3,enum=temporalio.cafe.StationOrderItemStatus" json:"status,omitempty"`
+	// Why the item failed, shown on its remake.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *ProcessPaymentRefundResult) Reset() {
-	*x = ProcessPaymentRefundResult{}
+func (x *StationOrderItemStatusInput) Reset() {
+	*x = StationOrderItemStatusInput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cafe_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1340,13 +1896,13 @@ func (x *ProcessPaymentRefundResult) Reset() {
 	}
 }
 
-func (x *ProcessPaymentRefundResult) String() string {
+func (x *StationOrderItemStatusInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessPaymentRefundResult) ProtoMessage() {}
+func (*StationOrderItemStatusInput) ProtoMessage() {}
 
-func (x *ProcessPaymentRefundResult) ProtoReflect() protoreflect.Message {
+func (x *StationOrderItemStatusInput) ProtoReflect() protoreflect.Message {
 	mi := &file_cafe_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1358,22 +1914,43 @@ func (x *ProcessPaymentRefundResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessPaymentRefundResult.ProtoReflect.Descriptor instead.
-func (*ProcessPaymentRefundResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use StationOrderItemStatusInput.ProtoReflect.Descriptor instead.
+func (*StationOrderItemStatusInput) Descriptor() ([]byte, []int) {
 	return file_cafe_proto_rawDescGZIP(), []int{22}
 }
 
-type AddLoyaltyPointsInput struct {
+func (x *StationOrderItemStatusInput) GetLine() uint32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *StationOrderItemStatusInput) GetStatus() StationOrderItemStatus {
+	if x != nil {
+		return x.Status
+	}
+	return StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_PENDING
+}
+
+func (x *StationOrderItemStatusInput) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// StationOrderPriorityInput moves an order waiting in its station's queue, such as to rush it.
+type StationOrderPriorityInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email  string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Points uint32 `protobuf:"varint,2,opt,name=points,proto3" json:"points,omitempty"`
+	Priority uint32 `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
-func (x *AddLoyaltyPointsInput) Reset() {
-	*x = AddLoyaltyPointsInput{}
+func (x *StationOrderPriorityInput) Reset() {
+	*x = StationOrderPriorityInput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cafe_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1381,397 +1958,5709 @@ func (x *AddLoyaltyPointsInput) Reset() {
 	}
 }
 
-func (x *AddLoyaltyPointsInput) String() string {
+func (x *StationOrderPriorityInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddLoyaltyPointsInput) ProtoMessage() {}
+func (*StationOrderPriorityInput) ProtoMessage() {}
 
-func (x *AddLoyaltyPointsInput) ProtoReflect() protoreflect.Message {
+func (x *StationOrderPriorityInput) ProtoReflect() protoreflect.Message {
 	mi := &file_cafe_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use AddLoyaltyPointsInput.ProtoReflect.Descriptor instead.
-func (*AddLoyaltyPointsInput) Descriptor() ([]byte, []int) {
-	return file_cafe_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *AddLoyaltyPointsInput) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
-func (x *AddLoyaltyPointsInput) GetPoints() uint32 {
-	if x != nil {
-		return x.Points
-	}
-	return 0
-}
-
-type AddLoyaltyPointsResult struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *AddLoyaltyPointsResult) Reset() {
-	*x = AddLoyaltyPointsResult{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cafe_proto_msgTypes[24]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *AddLoyaltyPointsResult) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*AddLoyaltyPointsResult) ProtoMessage() {}
-
-func (x *AddLoyaltyPointsResult) ProtoReflect() protoreflect.Message {
-	mi := &file_cafe_proto_msgTypes[24]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StationOrderPriorityInput.ProtoReflect.Descriptor instead.
+func (*StationOrderPriorityInput) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StationOrderPriorityInput) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type StationOrderItemsUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Add    []*OrderLineItem `protobuf:"bytes,1,rep,name=add,proto3" json:"add,omitempty"`
+	Remove []*OrderLineItem `protobuf:"bytes,2,rep,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (x *StationOrderItemsUpdate) Reset() {
+	*x = StationOrderItemsUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StationOrderItemsUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StationOrderItemsUpdate) ProtoMessage() {}
+
+func (x *StationOrderItemsUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StationOrderItemsUpdate.ProtoReflect.Descriptor instead.
+func (*StationOrderItemsUpdate) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *StationOrderItemsUpdate) GetAdd() []*OrderLineItem {
+	if x != nil {
+		return x.Add
+	}
+	return nil
+}
+
+func (x *StationOrderItemsUpdate) GetRemove() []*OrderLineItem {
+	if x != nil {
+		return x.Remove
+	}
+	return nil
+}
+
+type StationOrderStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Station string                  `protobuf:"bytes,1,opt,name=station,proto3" json:"station,omitempty"`
+	Name    string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Open    bool                    `protobuf:"varint,3,opt,name=open,proto3" json:"open,omitempty"`
+	Items   []*StationOrderLineItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	// The station's queue has let the order through, so its items can be started.
+	Admitted bool `protobuf:"varint,5,opt,name=admitted,proto3" json:"admitted,omitempty"`
+}
+
+func (x *StationOrderStatus) Reset() {
+	*x = StationOrderStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StationOrderStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StationOrderStatus) ProtoMessage() {}
+
+func (x *StationOrderStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StationOrderStatus.ProtoReflect.Descriptor instead.
+func (*StationOrderStatus) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *StationOrderStatus) GetStation() string {
+	if x != nil {
+		return x.Station
+	}
+	return ""
+}
+
+func (x *StationOrderStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StationOrderStatus) GetOpen() bool {
+	if x != nil {
+		return x.Open
+	}
+	return false
+}
+
+func (x *StationOrderStatus) GetItems() []*StationOrderLineItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *StationOrderStatus) GetAdmitted() bool {
+	if x != nil {
+		return x.Admitted
+	}
+	return false
+}
+
+type StationOrderResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StationOrderResult) Reset() {
+	*x = StationOrderResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StationOrderResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StationOrderResult) ProtoMessage() {}
+
+func (x *StationOrderResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StationOrderResult.ProtoReflect.Descriptor instead.
+func (*StationOrderResult) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{26}
+}
+
+type StationQueueInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Options *StationOptions `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *StationQueueInput) Reset() {
+	*x = StationQueueInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StationQueueInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StationQueueInput) ProtoMessage() {}
+
+func (x *StationQueueInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StationQueueInput.ProtoReflect.Descriptor instead.
+func (*StationQueueInput) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *StationQueueInput) GetOptions() *StationOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// StationQueueOrder is an order waiting for or being made by a station.
+type StationQueueOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The station order workflow ID.
+	OrderId  string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Priority uint32 `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Items which haven't been made yet.
+	Items uint32 `protobuf:"varint,4,opt,name=items,proto3" json:"items,omitempty"`
+	// Closed orders leave the queue.
+	Open     bool `protobuf:"varint,5,opt,name=open,proto3" json:"open,omitempty"`
+	Admitted bool `protobuf:"varint,6,opt,name=admitted,proto3" json:"admitted,omitempty"`
+	// Arrival order, orders of the same priority are made first come first served.
+	Seq uint64 `protobuf:"varint,7,opt,name=seq,proto3" json:"seq,omitempty"`
+}
+
+func (x *StationQueueOrder) Reset() {
+	*x = StationQueueOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StationQueueOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StationQueueOrder) ProtoMessage() {}
+
+func (x *StationQueueOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StationQueueOrder.ProtoReflect.Descriptor instead.
+func (*StationQueueOrder) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *StationQueueOrder) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *StationQueueOrder) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StationQueueOrder) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *StationQueueOrder) GetItems() uint32 {
+	if x != nil {
+		return x.Items
+	}
+	return 0
+}
+
+func (x *StationQueueOrder) GetOpen() bool {
+	if x != nil {
+		return x.Open
+	}
+	return false
+}
+
+func (x *StationQueueOrder) GetAdmitted() bool {
+	if x != nil {
+		return x.Admitted
+	}
+	return false
+}
+
+func (x *StationQueueOrder) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+type StationQueuePosition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Order    *StationQueueOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Position uint32             `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	// Time until the station is expected to start the order.
+	EstimatedWait *durationpb.Duration `protobuf:"bytes,3,opt,name=estimated_wait,json=estimatedWait,proto3" json:"estimated_wait,omitempty"`
+}
+
+func (x *StationQueuePosition) Reset() {
+	*x = StationQueuePosition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StationQueuePosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StationQueuePosition) ProtoMessage() {}
+
+func (x *StationQueuePosition) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StationQueuePosition.ProtoReflect.Descriptor instead.
+func (*StationQueuePosition) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *StationQueuePosition) GetOrder() *StationQueueOrder {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *StationQueuePosition) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *StationQueuePosition) GetEstimatedWait() *durationpb.Duration {
+	if x != nil {
+		return x.EstimatedWait
+	}
+	return nil
+}
+
+type StationQueueStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Station  string                  `protobuf:"bytes,1,opt,name=station,proto3" json:"station,omitempty"`
+	Capacity uint32                  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Orders   []*StationQueuePosition `protobuf:"bytes,3,rep,name=orders,proto3" json:"orders,omitempty"`
+}
+
+func (x *StationQueueStatus) Reset() {
+	*x = StationQueueStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StationQueueStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StationQueueStatus) ProtoMessage() {}
+
+func (x *StationQueueStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StationQueueStatus.ProtoReflect.Descriptor instead.
+func (*StationQueueStatus) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *StationQueueStatus) GetStation() string {
+	if x != nil {
+		return x.Station
+	}
+	return ""
+}
+
+func (x *StationQueueStatus) GetCapacity() uint32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *StationQueueStatus) GetOrders() []*StationQueuePosition {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type QueueStationOrderInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Station string             `protobuf:"bytes,1,opt,name=station,proto3" json:"station,omitempty"`
+	Order   *StationQueueOrder `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
+}
+
+func (x *QueueStationOrderInput) Reset() {
+	*x = QueueStationOrderInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueueStationOrderInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueStationOrderInput) ProtoMessage() {}
+
+func (x *QueueStationOrderInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueStationOrderInput.ProtoReflect.Descriptor instead.
+func (*QueueStationOrderInput) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *QueueStationOrderInput) GetStation() string {
+	if x != nil {
+		return x.Station
+	}
+	return ""
+}
+
+func (x *QueueStationOrderInput) GetOrder() *StationQueueOrder {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type QueueStationOrderResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueueStationOrderResult) Reset() {
+	*x = QueueStationOrderResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueueStationOrderResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueStationOrderResult) ProtoMessage() {}
+
+func (x *QueueStationOrderResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueStationOrderResult.ProtoReflect.Descriptor instead.
+func (*QueueStationOrderResult) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{32}
+}
+
+type FindClosedStationOrdersInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderIds []string `protobuf:"bytes,1,rep,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+}
+
+func (x *FindClosedStationOrdersInput) Reset() {
+	*x = FindClosedStationOrdersInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindClosedStationOrdersInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindClosedStationOrdersInput) ProtoMessage() {}
+
+func (x *FindClosedStationOrdersInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindClosedStationOrdersInput.ProtoReflect.Descriptor instead.
+func (*FindClosedStationOrdersInput) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *FindClosedStationOrdersInput) GetOrderIds() []string {
+	if x != nil {
+		return x.OrderIds
+	}
+	return nil
+}
+
+type FindClosedStationOrdersResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The orders whose workflows are no longer running.
+	OrderIds []string `protobuf:"bytes,1,rep,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+}
+
+func (x *FindClosedStationOrdersResult) Reset() {
+	*x = FindClosedStationOrdersResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindClosedStationOrdersResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindClosedStationOrdersResult) ProtoMessage() {}
+
+func (x *FindClosedStationOrdersResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindClosedStationOrdersResult.ProtoReflect.Descriptor instead.
+func (*FindClosedStationOrdersResult) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FindClosedStationOrdersResult) GetOrderIds() []string {
+	if x != nil {
+		return x.OrderIds
+	}
+	return nil
+}
+
+type CustomerLoyaltyPointsBalance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Points uint32 `protobuf:"varint,1,opt,name=points,proto3" json:"points,omitempty"`
+	// The customer's tier, empty if they haven't reached one.
+	Tier string `protobuf:"bytes,2,opt,name=tier,proto3" json:"tier,omitempty"`
+	// Spend within the tier window which counts towards the customer's tier.
+	Spend uint32 `protobuf:"varint,3,opt,name=spend,proto3" json:"spend,omitempty"`
+	// The points which expire next, and when.
+	ExpiringPoints uint32                 `protobuf:"varint,4,opt,name=expiring_points,json=expiringPoints,proto3" json:"expiring_points,omitempty"`
+	Expires        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires,proto3" json:"expires,omitempty"`
+}
+
+func (x *CustomerLoyaltyPointsBalance) Reset() {
+	*x = CustomerLoyaltyPointsBalance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomerLoyaltyPointsBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerLoyaltyPointsBalance) ProtoMessage() {}
+
+func (x *CustomerLoyaltyPointsBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerLoyaltyPointsBalance.ProtoReflect.Descriptor instead.
+func (*CustomerLoyaltyPointsBalance) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CustomerLoyaltyPointsBalance) GetPoints() uint32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *CustomerLoyaltyPointsBalance) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *CustomerLoyaltyPointsBalance) GetSpend() uint32 {
+	if x != nil {
+		return x.Spend
+	}
+	return 0
+}
+
+func (x *CustomerLoyaltyPointsBalance) GetExpiringPoints() uint32 {
+	if x != nil {
+		return x.ExpiringPoints
+	}
+	return 0
+}
+
+func (x *CustomerLoyaltyPointsBalance) GetExpires() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expires
+	}
+	return nil
+}
+
+type CustomerLoyaltyPointsEarned struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Points uint32 `protobuf:"varint,1,opt,name=points,proto3" json:"points,omitempty"`
+	// The amount spent to earn the points, counted towards the customer's tier.
+	Spend uint32 `protobuf:"varint,2,opt,name=spend,proto3" json:"spend,omitempty"`
+	// The program in force when the points were earned. Customers keep the most recent one.
+	Program *LoyaltyProgram `protobuf:"bytes,3,opt,name=program,proto3" json:"program,omitempty"`
+	// The order the points were earned on.
+	OrderId string `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+}
+
+func (x *CustomerLoyaltyPointsEarned) Reset() {
+	*x = CustomerLoyaltyPointsEarned{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomerLoyaltyPointsEarned) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerLoyaltyPointsEarned) ProtoMessage() {}
+
+func (x *CustomerLoyaltyPointsEarned) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerLoyaltyPointsEarned.ProtoReflect.Descriptor instead.
+func (*CustomerLoyaltyPointsEarned) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CustomerLoyaltyPointsEarned) GetPoints() uint32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *CustomerLoyaltyPointsEarned) GetSpend() uint32 {
+	if x != nil {
+		return x.Spend
+	}
+	return 0
+}
+
+func (x *CustomerLoyaltyPointsEarned) GetProgram() *LoyaltyProgram {
+	if x != nil {
+		return x.Program
+	}
+	return nil
+}
+
+func (x *CustomerLoyaltyPointsEarned) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+// LoyaltyProgram configures how customers earn points, the tiers they can reach and when their
+// points expire.
+type LoyaltyProgram struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Points given to new customers.
+	StartingBalance uint32 `protobuf:"varint,1,opt,name=starting_balance,json=startingBalance,proto3" json:"starting_balance,omitempty"`
+	// Points earned for each item bought.
+	PointsPerItem uint32 `protobuf:"varint,2,opt,name=points_per_item,json=pointsPerItem,proto3" json:"points_per_item,omitempty"`
+	// Points earned for each whole dollar spent.
+	PointsPerDollar uint32               `protobuf:"varint,3,opt,name=points_per_dollar,json=pointsPerDollar,proto3" json:"points_per_dollar,omitempty"`
+	Multipliers     []*LoyaltyMultiplier `protobuf:"bytes,4,rep,name=multipliers,proto3" json:"multipliers,omitempty"`
+	Promotions      []*LoyaltyPromotion  `protobuf:"bytes,5,rep,name=promotions,proto3" json:"promotions,omitempty"`
+	Tiers           []*LoyaltyTier       `protobuf:"bytes,6,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	// Days after which points expire, points never expire if 0.
+	PointsExpiryDays uint32 `protobuf:"varint,7,opt,name=points_expiry_days,json=pointsExpiryDays,proto3" json:"points_expiry_days,omitempty"`
+	// Days of spend which count towards a customer's tier.
+	TierWindowDays uint32 `protobuf:"varint,8,opt,name=tier_window_days,json=tierWindowDays,proto3" json:"tier_window_days,omitempty"`
+}
+
+func (x *LoyaltyProgram) Reset() {
+	*x = LoyaltyProgram{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoyaltyProgram) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoyaltyProgram) ProtoMessage() {}
+
+func (x *LoyaltyProgram) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoyaltyProgram.ProtoReflect.Descriptor instead.
+func (*LoyaltyProgram) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *LoyaltyProgram) GetStartingBalance() uint32 {
+	if x != nil {
+		return x.StartingBalance
+	}
+	return 0
+}
+
+func (x *LoyaltyProgram) GetPointsPerItem() uint32 {
+	if x != nil {
+		return x.PointsPerItem
+	}
+	return 0
+}
+
+func (x *LoyaltyProgram) GetPointsPerDollar() uint32 {
+	if x != nil {
+		return x.PointsPerDollar
+	}
+	return 0
+}
+
+func (x *LoyaltyProgram) GetMultipliers() []*LoyaltyMultiplier {
+	if x != nil {
+		return x.Multipliers
+	}
+	return nil
+}
+
+func (x *LoyaltyProgram) GetPromotions() []*LoyaltyPromotion {
+	if x != nil {
+		return x.Promotions
+	}
+	return nil
+}
+
+func (x *LoyaltyProgram) GetTiers() []*LoyaltyTier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+func (x *LoyaltyProgram) GetPointsExpiryDays() uint32 {
+	if x != nil {
+		return x.PointsExpiryDays
+	}
+	return 0
+}
+
+func (x *LoyaltyProgram) GetTierWindowDays() uint32 {
+	if x != nil {
+		return x.TierWindowDays
+	}
+	return 0
+}
+
+// LoyaltyMultiplier scales the points earned for a type of product.
+type LoyaltyMultiplier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    ProductType `protobuf:"varint,1,opt,name=type,proto3,enum=temporalio.cafe.ProductType" json:"type,omitempty"`
+	Percent uint32      `protobuf:"varint,2,opt,name=percent,proto3" json:"percent,omitempty"`
+}
+
+func (x *LoyaltyMultiplier) Reset() {
+	*x = LoyaltyMultiplier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoyaltyMultiplier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoyaltyMultiplier) ProtoMessage() {}
+
+func (x *LoyaltyMultiplier) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoyaltyMultiplier.ProtoReflect.Descriptor instead.
+func (*LoyaltyMultiplier) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *LoyaltyMultiplier) GetType() ProductType {
+	if x != nil {
+		return x.Type
+	}
+	return ProductType_PRODUCT_TYPE_UNKNOWN
+}
+
+func (x *LoyaltyMultiplier) GetPercent() uint32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+// LoyaltyPromotion awards extra points for matching items bought while it runs. A promotion with
+// no type or item matches every item.
+type LoyaltyPromotion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type ProductType `protobuf:"varint,2,opt,name=type,proto3,enum=temporalio.cafe.ProductType" json:"type,omitempty"`
+	Item string      `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	// Extra points as a percentage of those the item earns.
+	Percent uint32 `protobuf:"varint,4,opt,name=percent,proto3" json:"percent,omitempty"`
+	// Extra points for each matching item bought.
+	BonusPoints uint32                 `protobuf:"varint,5,opt,name=bonus_points,json=bonusPoints,proto3" json:"bonus_points,omitempty"`
+	Starts      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=starts,proto3" json:"starts,omitempty"`
+	Ends        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ends,proto3" json:"ends,omitempty"`
+}
+
+func (x *LoyaltyPromotion) Reset() {
+	*x = LoyaltyPromotion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoyaltyPromotion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoyaltyPromotion) ProtoMessage() {}
+
+func (x *LoyaltyPromotion) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoyaltyPromotion.ProtoReflect.Descriptor instead.
+func (*LoyaltyPromotion) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *LoyaltyPromotion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LoyaltyPromotion) GetType() ProductType {
+	if x != nil {
+		return x.Type
+	}
+	return ProductType_PRODUCT_TYPE_UNKNOWN
+}
+
+func (x *LoyaltyPromotion) GetItem() string {
+	if x != nil {
+		return x.Item
+	}
+	return ""
+}
+
+func (x *LoyaltyPromotion) GetPercent() uint32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *LoyaltyPromotion) GetBonusPoints() uint32 {
+	if x != nil {
+		return x.BonusPoints
+	}
+	return 0
+}
+
+func (x *LoyaltyPromotion) GetStarts() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Starts
+	}
+	return nil
+}
+
+func (x *LoyaltyPromotion) GetEnds() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Ends
+	}
+	return nil
+}
+
+// LoyaltyTier is reached by customers who spend at least spend within the tier window, who then
+// earn percent extra points.
+type LoyaltyTier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Spend   uint32 `protobuf:"varint,2,opt,name=spend,proto3" json:"spend,omitempty"`
+	Percent uint32 `protobuf:"varint,3,opt,name=percent,proto3" json:"percent,omitempty"`
+}
+
+func (x *LoyaltyTier) Reset() {
+	*x = LoyaltyTier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoyaltyTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoyaltyTier) ProtoMessage() {}
+
+func (x *LoyaltyTier) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoyaltyTier.ProtoReflect.Descriptor instead.
+func (*LoyaltyTier) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *LoyaltyTier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LoyaltyTier) GetSpend() uint32 {
+	if x != nil {
+		return x.Spend
+	}
+	return 0
+}
+
+func (x *LoyaltyTier) GetPercent() uint32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+type CustomerLoyaltyPointsRedeemInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifies the reservation, so that retries are not reserved twice and it can be released.
+	Reference string `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	Points    uint32 `protobuf:"varint,2,opt,name=points,proto3" json:"points,omitempty"`
+	// The order paid for with the points.
+	OrderId string `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+}
+
+func (x *CustomerLoyaltyPointsRedeemInput) Reset() {
+	*x = CustomerLoyaltyPointsRedeemInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomerLoyaltyPointsRedeemInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerLoyaltyPointsRedeemInput) ProtoMessage() {}
+
+func (x *CustomerLoyaltyPointsRedeemInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerLoyaltyPointsRedeemInput.ProtoReflect.Descriptor instead.
+func (*CustomerLoyaltyPointsRedeemInput) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CustomerLoyaltyPointsRedeemInput) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *CustomerLoyaltyPointsRedeemInput) GetPoints() uint32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *CustomerLoyaltyPointsRedeemInput) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type CustomerLoyaltyPointsRedeemResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Points reserved, which may be fewer than requested.
+	Points  uint32 `protobuf:"varint,1,opt,name=points,proto3" json:"points,omitempty"`
+	Balance uint32 `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (x *CustomerLoyaltyPointsRedeemResult) Reset() {
+	*x = CustomerLoyaltyPointsRedeemResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomerLoyaltyPointsRedeemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerLoyaltyPointsRedeemResult) ProtoMessage() {}
+
+func (x *CustomerLoyaltyPointsRedeemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerLoyaltyPointsRedeemResult.ProtoReflect.Descriptor instead.
+func (*CustomerLoyaltyPointsRedeemResult) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CustomerLoyaltyPointsRedeemResult) GetPoints() uint32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *CustomerLoyaltyPointsRedeemResult) GetBalance() uint32 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+type CustomerLoyaltyPointsReleaseInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reference string `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	Points    uint32 `protobuf:"varint,2,opt,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *CustomerLoyaltyPointsReleaseInput) Reset() {
+	*x = CustomerLoyaltyPointsReleaseInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomerLoyaltyPointsReleaseInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerLoyaltyPointsReleaseInput) ProtoMessage() {}
+
+func (x *CustomerLoyaltyPointsReleaseInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerLoyaltyPointsReleaseInput.ProtoReflect.Descriptor instead.
+func (*CustomerLoyaltyPointsReleaseInput) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CustomerLoyaltyPointsReleaseInput) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *CustomerLoyaltyPointsReleaseInput) GetPoints() uint32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+// CustomerLoyaltyPointsAdjustInput corrects a customer's balance, adding points if positive and
+// removing them if negative.
+type CustomerLoyaltyPointsAdjustInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Points int32  `protobuf:"varint,1,opt,name=points,proto3" json:"points,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Identifies the adjustment so that retries are only applied once.
+	Reference string `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"`
+}
+
+func (x *CustomerLoyaltyPointsAdjustInput) Reset() {
+	*x = CustomerLoyaltyPointsAdjustInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomerLoyaltyPointsAdjustInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerLoyaltyPointsAdjustInput) ProtoMessage() {}
+
+func (x *CustomerLoyaltyPointsAdjustInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerLoyaltyPointsAdjustInput.ProtoReflect.Descriptor instead.
+func (*CustomerLoyaltyPointsAdjustInput) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CustomerLoyaltyPointsAdjustInput) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *CustomerLoyaltyPointsAdjustInput) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CustomerLoyaltyPointsAdjustInput) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+// CustomerLoyaltyTransaction is a change to a customer's points.
+type CustomerLoyaltyTransaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type   CustomerLoyaltyTransactionType `protobuf:"varint,1,opt,name=type,proto3,enum=temporalio.cafe.CustomerLoyaltyTransactionType" json:"type,omitempty"`
+	Points int32                          `protobuf:"varint,2,opt,name=points,proto3" json:"points,omitempty"`
+	// The balance after the transaction.
+	Balance   uint32                 `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	Reference string                 `protobuf:"bytes,4,opt,name=reference,proto3" json:"reference,omitempty"`
+	Reason    string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Time      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+	// Position in the customer's ledger, starting from 1.
+	Seq     uint64 `protobuf:"varint,7,opt,name=seq,proto3" json:"seq,omitempty"`
+	OrderId string `protobuf:"bytes,8,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+}
+
+func (x *CustomerLoyaltyTransaction) Reset() {
+	*x = CustomerLoyaltyTransaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomerLoyaltyTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerLoyaltyTransaction) ProtoMessage() {}
+
+func (x *CustomerLoyaltyTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerLoyaltyTransaction.ProtoReflect.Descriptor instead.
+func (*CustomerLoyaltyTransaction) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CustomerLoyaltyTransaction) GetType() CustomerLoyaltyTransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return CustomerLoyaltyTransactionType_CUSTOMER_LOYALTY_TRANSACTION_TYPE_UNKNOWN
+}
+
+func (x *CustomerLoyaltyTransaction) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *CustomerLoyaltyTransaction) GetBalance() uint32 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *CustomerLoyaltyTransaction) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *CustomerLoyaltyTransaction) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CustomerLoyaltyTransaction) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *CustomerLoyaltyTransaction) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *CustomerLoyaltyTransaction) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+// CustomerLedgerInput requests a page of ledger entries, newest first.
+type CustomerLedgerInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only return entries before this position, 0 for the newest entries.
+	Before uint64 `protobuf:"varint,1,opt,name=before,proto3" json:"before,omitempty"`
+	Limit  uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *CustomerLedgerInput) Reset() {
+	*x = CustomerLedgerInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomerLedgerInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerLedgerInput) ProtoMessage() {}
+
+func (x *CustomerLedgerInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerLedgerInput.ProtoReflect.Descriptor instead.
+func (*CustomerLedgerInput) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CustomerLedgerInput) GetBefore() uint64 {
+	if x != nil {
+		return x.Before
+	}
+	return 0
+}
+
+func (x *CustomerLedgerInput) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type CustomerLedger struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*CustomerLoyaltyTransaction `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Pass as before to fetch the next page, 0 if there are no more entries in the workflow.
+	Next uint64 `protobuf:"varint,2,opt,name=next,proto3" json:"next,omitempty"`
+	// Entries before this position have been archived.
+	Archived uint64 `protobuf:"varint,3,opt,name=archived,proto3" json:"archived,omitempty"`
+}
+
+func (x *CustomerLedger) Reset() {
+	*x = CustomerLedger{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomerLedger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerLedger) ProtoMessage() {}
+
+func (x *CustomerLedger) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerLedger.ProtoReflect.Descriptor instead.
+func (*CustomerLedger) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CustomerLedger) GetEntries() []*CustomerLoyaltyTransaction {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *CustomerLedger) GetNext() uint64 {
+	if x != nil {
+		return x.Next
+	}
+	return 0
+}
+
+func (x *CustomerLedger) GetArchived() uint64 {
+	if x != nil {
+		return x.Archived
+	}
+	return 0
+}
+
+type CustomerStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email   string                        `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Loyalty *CustomerLoyaltyPointsBalance `protobuf:"bytes,2,opt,name=loyalty,proto3" json:"loyalty,omitempty"`
+	// The most recent transactions, newest first.
+	Transactions []*CustomerLoyaltyTransaction `protobuf:"bytes,3,rep,name=transactions,proto3" json:"transactions,omitempty"`
+}
+
+func (x *CustomerStatus) Reset() {
+	*x = CustomerStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomerStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerStatus) ProtoMessage() {}
+
+func (x *CustomerStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerStatus.ProtoReflect.Descriptor instead.
+func (*CustomerStatus) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CustomerStatus) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CustomerStatus) GetLoyalty() *CustomerLoyaltyPointsBalance {
+	if x != nil {
+		return x.Loyalty
+	}
+	return nil
+}
+
+func (x *CustomerStatus) GetTransactions() []*CustomerLoyaltyTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type CustomerInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email   string          `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Program *LoyaltyProgram `protobuf:"bytes,2,opt,name=program,proto3" json:"program,omitempty"`
+}
+
+func (x *CustomerInput) Reset() {
+	*x = CustomerInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomerInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerInput) ProtoMessage() {}
+
+func (x *CustomerInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerInput.ProtoReflect.Descriptor instead.
+func (*CustomerInput) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CustomerInput) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CustomerInput) GetProgram() *LoyaltyProgram {
+	if x != nil {
+		return x.Program
+	}
+	return nil
+}
+
+type CustomerForgetResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Orders the customer earned or spent points on, which may also hold their details.
+	OrderIds []string `protobuf:"bytes,1,rep,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+}
+
+func (x *CustomerForgetResult) Reset() {
+	*x = CustomerForgetResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomerForgetResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerForgetResult) ProtoMessage() {}
+
+func (x *CustomerForgetResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerForgetResult.ProtoReflect.Descriptor instead.
+func (*CustomerForgetResult) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CustomerForgetResult) GetOrderIds() []string {
+	if x != nil {
+		return x.OrderIds
+	}
+	return nil
+}
+
+type CustomerErasureInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email  string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Identifies the customer in the erasure log, a keyed hash of their email.
+	Subject string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+}
+
+func (x *CustomerErasureInput) Reset() {
+	*x = CustomerErasureInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomerErasureInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerErasureInput) ProtoMessage() {}
+
+func (x *CustomerErasureInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerErasureInput.ProtoReflect.Descriptor instead.
+func (*CustomerErasureInput) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CustomerErasureInput) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CustomerErasureInput) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CustomerErasureInput) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+// CustomerErasureAudit records that a customer's data was erased. The customer is identified by a
+// keyed hash of their email rather than the email itself.
+type CustomerErasureAudit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subject string                 `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Reason  string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Time    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	// Whether there was a customer workflow to erase.
+	CustomerFound bool `protobuf:"varint,4,opt,name=customer_found,json=customerFound,proto3" json:"customer_found,omitempty"`
+	// Orders still running, which had the customer's details removed.
+	OrdersScrubbed []string `protobuf:"bytes,5,rep,name=orders_scrubbed,json=ordersScrubbed,proto3" json:"orders_scrubbed,omitempty"`
+	// Closed orders, whose histories are kept as a record of their payment.
+	OrdersRetained []string `protobuf:"bytes,6,rep,name=orders_retained,json=ordersRetained,proto3" json:"orders_retained,omitempty"`
+}
+
+func (x *CustomerErasureAudit) Reset() {
+	*x = CustomerErasureAudit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomerErasureAudit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerErasureAudit) ProtoMessage() {}
+
+func (x *CustomerErasureAudit) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerErasureAudit.ProtoReflect.Descriptor instead.
+func (*CustomerErasureAudit) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CustomerErasureAudit) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *CustomerErasureAudit) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CustomerErasureAudit) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *CustomerErasureAudit) GetCustomerFound() bool {
+	if x != nil {
+		return x.CustomerFound
+	}
+	return false
+}
+
+func (x *CustomerErasureAudit) GetOrdersScrubbed() []string {
+	if x != nil {
+		return x.OrdersScrubbed
+	}
+	return nil
+}
+
+func (x *CustomerErasureAudit) GetOrdersRetained() []string {
+	if x != nil {
+		return x.OrdersRetained
+	}
+	return nil
+}
+
+type CustomerErasureResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Audit *CustomerErasureAudit `protobuf:"bytes,1,opt,name=audit,proto3" json:"audit,omitempty"`
+}
+
+func (x *CustomerErasureResult) Reset() {
+	*x = CustomerErasureResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomerErasureResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerErasureResult) ProtoMessage() {}
+
+func (x *CustomerErasureResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerErasureResult.ProtoReflect.Descriptor instead.
+func (*CustomerErasureResult) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CustomerErasureResult) GetAudit() *CustomerErasureAudit {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+type Payment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authcode string `protobuf:"bytes,1,opt,name=authcode,proto3" json:"authcode,omitempty"`
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Payment) GetAuthcode() string {
+	if x != nil {
+		return x.Authcode
+	}
+	return ""
+}
+
+type AuthorizePaymentInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token  string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Amount uint32 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Identifies the authorization, so that retrying it doesn't place a second hold.
+	Reference string `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"`
+}
+
+func (x *AuthorizePaymentInput) Reset() {
+	*x = AuthorizePaymentInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizePaymentInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizePaymentInput) ProtoMessage() {}
+
+func (x *AuthorizePaymentInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizePaymentInput.ProtoReflect.Descriptor instead.
+func (*AuthorizePaymentInput) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AuthorizePaymentInput) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AuthorizePaymentInput) GetAmount() uint32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AuthorizePaymentInput) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+type AuthorizePaymentResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payment *Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+}
+
+func (x *AuthorizePaymentResult) Reset() {
+	*x = AuthorizePaymentResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizePaymentResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizePaymentResult) ProtoMessage() {}
+
+func (x *AuthorizePaymentResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizePaymentResult.ProtoReflect.Descriptor instead.
+func (*AuthorizePaymentResult) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AuthorizePaymentResult) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+type CapturePaymentInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payment *Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	Amount  uint32   `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *CapturePaymentInput) Reset() {
+	*x = CapturePaymentInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CapturePaymentInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapturePaymentInput) ProtoMessage() {}
+
+func (x *CapturePaymentInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapturePaymentInput.ProtoReflect.Descriptor instead.
+func (*CapturePaymentInput) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CapturePaymentInput) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *CapturePaymentInput) GetAmount() uint32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type CapturePaymentResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CapturePaymentResult) Reset() {
+	*x = CapturePaymentResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CapturePaymentResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapturePaymentResult) ProtoMessage() {}
+
+func (x *CapturePaymentResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapturePaymentResult.ProtoReflect.Descriptor instead.
+func (*CapturePaymentResult) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{58}
+}
+
+type VoidPaymentInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payment *Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+}
+
+func (x *VoidPaymentInput) Reset() {
+	*x = VoidPaymentInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoidPaymentInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidPaymentInput) ProtoMessage() {}
+
+func (x *VoidPaymentInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidPaymentInput.ProtoReflect.Descriptor instead.
+func (*VoidPaymentInput) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *VoidPaymentInput) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+type VoidPaymentResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *VoidPaymentResult) Reset() {
+	*x = VoidPaymentResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoidPaymentResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidPaymentResult) ProtoMessage() {}
+
+func (x *VoidPaymentResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidPaymentResult.ProtoReflect.Descriptor instead.
+func (*VoidPaymentResult) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{60}
+}
+
+type ProcessPaymentRefundInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payment *Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	Amount  uint32   `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *ProcessPaymentRefundInput) Reset() {
+	*x = ProcessPaymentRefundInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessPaymentRefundInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessPaymentRefundInput) ProtoMessage() {}
+
+func (x *ProcessPaymentRefundInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessPaymentRefundInput.ProtoReflect.Descriptor instead.
+func (*ProcessPaymentRefundInput) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ProcessPaymentRefundInput) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *ProcessPaymentRefundInput) GetAmount() uint32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type ProcessPaymentRefundResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ProcessPaymentRefundResult) Reset() {
+	*x = ProcessPaymentRefundResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessPaymentRefundResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessPaymentRefundResult) ProtoMessage() {}
+
+func (x *ProcessPaymentRefundResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessPaymentRefundResult.ProtoReflect.Descriptor instead.
+func (*ProcessPaymentRefundResult) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{62}
+}
+
+type RedeemGiftCardInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number    string `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	Reference string `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	Amount    uint32 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *RedeemGiftCardInput) Reset() {
+	*x = RedeemGiftCardInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedeemGiftCardInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemGiftCardInput) ProtoMessage() {}
+
+func (x *RedeemGiftCardInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemGiftCardInput.ProtoReflect.Descriptor instead.
+func (*RedeemGiftCardInput) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RedeemGiftCardInput) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *RedeemGiftCardInput) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *RedeemGiftCardInput) GetAmount() uint32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type RedeemGiftCardResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount uint32 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *RedeemGiftCardResult) Reset() {
+	*x = RedeemGiftCardResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedeemGiftCardResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemGiftCardResult) ProtoMessage() {}
+
+func (x *RedeemGiftCardResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemGiftCardResult.ProtoReflect.Descriptor instead.
+func (*RedeemGiftCardResult) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *RedeemGiftCardResult) GetAmount() uint32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type RefundGiftCardInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number    string `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	Reference string `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	Amount    uint32 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *RefundGiftCardInput) Reset() {
+	*x = RefundGiftCardInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefundGiftCardInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundGiftCardInput) ProtoMessage() {}
+
+func (x *RefundGiftCardInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundGiftCardInput.ProtoReflect.Descriptor instead.
+func (*RefundGiftCardInput) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RefundGiftCardInput) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *RefundGiftCardInput) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *RefundGiftCardInput) GetAmount() uint32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type RefundGiftCardResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RefundGiftCardResult) Reset() {
+	*x = RefundGiftCardResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefundGiftCardResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundGiftCardResult) ProtoMessage() {}
+
+func (x *RefundGiftCardResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundGiftCardResult.ProtoReflect.Descriptor instead.
+func (*RefundGiftCardResult) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{66}
+}
+
+type RedeemLoyaltyPointsInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email     string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Reference string `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	Points    uint32 `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	OrderId   string `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+}
+
+func (x *RedeemLoyaltyPointsInput) Reset() {
+	*x = RedeemLoyaltyPointsInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedeemLoyaltyPointsInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemLoyaltyPointsInput) ProtoMessage() {}
+
+func (x *RedeemLoyaltyPointsInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemLoyaltyPointsInput.ProtoReflect.Descriptor instead.
+func (*RedeemLoyaltyPointsInput) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *RedeemLoyaltyPointsInput) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RedeemLoyaltyPointsInput) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *RedeemLoyaltyPointsInput) GetPoints() uint32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *RedeemLoyaltyPointsInput) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type RedeemLoyaltyPointsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Points uint32 `protobuf:"varint,1,opt,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *RedeemLoyaltyPointsResult) Reset() {
+	*x = RedeemLoyaltyPointsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedeemLoyaltyPointsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemLoyaltyPointsResult) ProtoMessage() {}
+
+func (x *RedeemLoyaltyPointsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemLoyaltyPointsResult.ProtoReflect.Descriptor instead.
+func (*RedeemLoyaltyPointsResult) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RedeemLoyaltyPointsResult) GetPoints() uint32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+type ReleaseLoyaltyPointsInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email     string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Reference string `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	Points    uint32 `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *ReleaseLoyaltyPointsInput) Reset() {
+	*x = ReleaseLoyaltyPointsInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseLoyaltyPointsInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseLoyaltyPointsInput) ProtoMessage() {}
+
+func (x *ReleaseLoyaltyPointsInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseLoyaltyPointsInput.ProtoReflect.Descriptor instead.
+func (*ReleaseLoyaltyPointsInput) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ReleaseLoyaltyPointsInput) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ReleaseLoyaltyPointsInput) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *ReleaseLoyaltyPointsInput) GetPoints() uint32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+type ReleaseLoyaltyPointsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReleaseLoyaltyPointsResult) Reset() {
+	*x = ReleaseLoyaltyPointsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseLoyaltyPointsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseLoyaltyPointsResult) ProtoMessage() {}
+
+func (x *ReleaseLoyaltyPointsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseLoyaltyPointsResult.ProtoReflect.Descriptor instead.
+func (*ReleaseLoyaltyPointsResult) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{70}
+}
+
+type AddLoyaltyPointsInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	// Items paid for, and those refunded, which earn points under the loyalty program.
+	Items   []*OrderLineItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Refunds []*OrderLineItem `protobuf:"bytes,4,rep,name=refunds,proto3" json:"refunds,omitempty"`
+	Spend   uint32           `protobuf:"varint,5,opt,name=spend,proto3" json:"spend,omitempty"`
+	OrderId string           `protobuf:"bytes,6,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+}
+
+func (x *AddLoyaltyPointsInput) Reset() {
+	*x = AddLoyaltyPointsInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddLoyaltyPointsInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddLoyaltyPointsInput) ProtoMessage() {}
+
+func (x *AddLoyaltyPointsInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddLoyaltyPointsInput.ProtoReflect.Descriptor instead.
+func (*AddLoyaltyPointsInput) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *AddLoyaltyPointsInput) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AddLoyaltyPointsInput) GetItems() []*OrderLineItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *AddLoyaltyPointsInput) GetRefunds() []*OrderLineItem {
+	if x != nil {
+		return x.Refunds
+	}
+	return nil
+}
+
+func (x *AddLoyaltyPointsInput) GetSpend() uint32 {
+	if x != nil {
+		return x.Spend
+	}
+	return 0
+}
+
+func (x *AddLoyaltyPointsInput) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type ArchiveCustomerLedgerInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email   string                        `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Entries []*CustomerLoyaltyTransaction `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ArchiveCustomerLedgerInput) Reset() {
+	*x = ArchiveCustomerLedgerInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveCustomerLedgerInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveCustomerLedgerInput) ProtoMessage() {}
+
+func (x *ArchiveCustomerLedgerInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveCustomerLedgerInput.ProtoReflect.Descriptor instead.
+func (*ArchiveCustomerLedgerInput) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ArchiveCustomerLedgerInput) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ArchiveCustomerLedgerInput) GetEntries() []*CustomerLoyaltyTransaction {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type ArchiveCustomerLedgerResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ArchiveCustomerLedgerResult) Reset() {
+	*x = ArchiveCustomerLedgerResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveCustomerLedgerResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveCustomerLedgerResult) ProtoMessage() {}
+
+func (x *ArchiveCustomerLedgerResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveCustomerLedgerResult.ProtoReflect.Descriptor instead.
+func (*ArchiveCustomerLedgerResult) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{73}
+}
+
+type ForgetCustomerInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *ForgetCustomerInput) Reset() {
+	*x = ForgetCustomerInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForgetCustomerInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForgetCustomerInput) ProtoMessage() {}
+
+func (x *ForgetCustomerInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForgetCustomerInput.ProtoReflect.Descriptor instead.
+func (*ForgetCustomerInput) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ForgetCustomerInput) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type ForgetCustomerResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Found    bool     `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	OrderIds []string `protobuf:"bytes,2,rep,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+}
+
+func (x *ForgetCustomerResult) Reset() {
+	*x = ForgetCustomerResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForgetCustomerResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForgetCustomerResult) ProtoMessage() {}
+
+func (x *ForgetCustomerResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForgetCustomerResult.ProtoReflect.Descriptor instead.
+func (*ForgetCustomerResult) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ForgetCustomerResult) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *ForgetCustomerResult) GetOrderIds() []string {
+	if x != nil {
+		return x.OrderIds
+	}
+	return nil
+}
+
+type ForgetOrderInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+}
+
+func (x *ForgetOrderInput) Reset() {
+	*x = ForgetOrderInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForgetOrderInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForgetOrderInput) ProtoMessage() {}
+
+func (x *ForgetOrderInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForgetOrderInput.ProtoReflect.Descriptor instead.
+func (*ForgetOrderInput) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ForgetOrderInput) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type ForgetOrderResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Running orders are scrubbed, closed orders are retained.
+	Running  bool `protobuf:"varint,1,opt,name=running,proto3" json:"running,omitempty"`
+	Retained bool `protobuf:"varint,2,opt,name=retained,proto3" json:"retained,omitempty"`
+}
+
+func (x *ForgetOrderResult) Reset() {
+	*x = ForgetOrderResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForgetOrderResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForgetOrderResult) ProtoMessage() {}
+
+func (x *ForgetOrderResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForgetOrderResult.ProtoReflect.Descriptor instead.
+func (*ForgetOrderResult) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ForgetOrderResult) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *ForgetOrderResult) GetRetained() bool {
+	if x != nil {
+		return x.Retained
+	}
+	return false
+}
+
+type DeleteCustomerRecordsInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *DeleteCustomerRecordsInput) Reset() {
+	*x = DeleteCustomerRecordsInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCustomerRecordsInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCustomerRecordsInput) ProtoMessage() {}
+
+func (x *DeleteCustomerRecordsInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCustomerRecordsInput.ProtoReflect.Descriptor instead.
+func (*DeleteCustomerRecordsInput) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *DeleteCustomerRecordsInput) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type DeleteCustomerRecordsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunsDeleted uint32 `protobuf:"varint,1,opt,name=runs_deleted,json=runsDeleted,proto3" json:"runs_deleted,omitempty"`
+}
+
+func (x *DeleteCustomerRecordsResult) Reset() {
+	*x = DeleteCustomerRecordsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCustomerRecordsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCustomerRecordsResult) ProtoMessage() {}
+
+func (x *DeleteCustomerRecordsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCustomerRecordsResult.ProtoReflect.Descriptor instead.
+func (*DeleteCustomerRecordsResult) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DeleteCustomerRecordsResult) GetRunsDeleted() uint32 {
+	if x != nil {
+		return x.RunsDeleted
+	}
+	return 0
+}
+
+type RecordCustomerErasureInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Audit *CustomerErasureAudit `protobuf:"bytes,1,opt,name=audit,proto3" json:"audit,omitempty"`
+}
+
+func (x *RecordCustomerErasureInput) Reset() {
+	*x = RecordCustomerErasureInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordCustomerErasureInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordCustomerErasureInput) ProtoMessage() {}
+
+func (x *RecordCustomerErasureInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordCustomerErasureInput.ProtoReflect.Descriptor instead.
+func (*RecordCustomerErasureInput) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *RecordCustomerErasureInput) GetAudit() *CustomerErasureAudit {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+type RecordCustomerErasureResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RecordCustomerErasureResult) Reset() {
+	*x = RecordCustomerErasureResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordCustomerErasureResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordCustomerErasureResult) ProtoMessage() {}
+
+func (x *RecordCustomerErasureResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordCustomerErasureResult.ProtoReflect.Descriptor instead.
+func (*RecordCustomerErasureResult) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{81}
+}
+
+type AddLoyaltyPointsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Points earned before any tier bonus, which is added by the customer.
+	Points uint32 `protobuf:"varint,1,opt,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *AddLoyaltyPointsResult) Reset() {
+	*x = AddLoyaltyPointsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddLoyaltyPointsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddLoyaltyPointsResult) ProtoMessage() {}
+
+func (x *AddLoyaltyPointsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddLoyaltyPointsResult.ProtoReflect.Descriptor instead.
+func (*AddLoyaltyPointsResult) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *AddLoyaltyPointsResult) GetPoints() uint32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+type GiftCardInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number  string                 `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	Balance uint32                 `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Expires *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires,proto3" json:"expires,omitempty"`
+}
+
+func (x *GiftCardInput) Reset() {
+	*x = GiftCardInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GiftCardInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiftCardInput) ProtoMessage() {}
+
+func (x *GiftCardInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiftCardInput.ProtoReflect.Descriptor instead.
+func (*GiftCardInput) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GiftCardInput) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *GiftCardInput) GetBalance() uint32 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *GiftCardInput) GetExpires() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expires
+	}
+	return nil
+}
+
+type GiftCardStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number  string                 `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	Balance uint32                 `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Expires *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires,proto3" json:"expires,omitempty"`
+	Expired bool                   `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`
+}
+
+func (x *GiftCardStatus) Reset() {
+	*x = GiftCardStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GiftCardStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiftCardStatus) ProtoMessage() {}
+
+func (x *GiftCardStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiftCardStatus.ProtoReflect.Descriptor instead.
+func (*GiftCardStatus) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GiftCardStatus) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *GiftCardStatus) GetBalance() uint32 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *GiftCardStatus) GetExpires() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expires
+	}
+	return nil
+}
+
+func (x *GiftCardStatus) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+type GiftCardTopUpInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount uint32 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *GiftCardTopUpInput) Reset() {
+	*x = GiftCardTopUpInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GiftCardTopUpInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiftCardTopUpInput) ProtoMessage() {}
+
+func (x *GiftCardTopUpInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiftCardTopUpInput.ProtoReflect.Descriptor instead.
+func (*GiftCardTopUpInput) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GiftCardTopUpInput) GetAmount() uint32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type GiftCardRedeemInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifies the redemption, so that retries are not redeemed twice and it can be refunded.
+	Reference string `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	Amount    uint32 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *GiftCardRedeemInput) Reset() {
+	*x = GiftCardRedeemInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GiftCardRedeemInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiftCardRedeemInput) ProtoMessage() {}
+
+func (x *GiftCardRedeemInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiftCardRedeemInput.ProtoReflect.Descriptor instead.
+func (*GiftCardRedeemInput) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GiftCardRedeemInput) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *GiftCardRedeemInput) GetAmount() uint32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type GiftCardRedeemResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Amount taken from the card, which may be less than requested.
+	Amount  uint32 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Balance uint32 `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (x *GiftCardRedeemResult) Reset() {
+	*x = GiftCardRedeemResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GiftCardRedeemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiftCardRedeemResult) ProtoMessage() {}
+
+func (x *GiftCardRedeemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiftCardRedeemResult.ProtoReflect.Descriptor instead.
+func (*GiftCardRedeemResult) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GiftCardRedeemResult) GetAmount() uint32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *GiftCardRedeemResult) GetBalance() uint32 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+type GiftCardRefundInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reference string `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	Amount    uint32 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *GiftCardRefundInput) Reset() {
+	*x = GiftCardRefundInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cafe_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GiftCardRefundInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiftCardRefundInput) ProtoMessage() {}
+
+func (x *GiftCardRefundInput) ProtoReflect() protoreflect.Message {
+	mi := &file_cafe_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiftCardRefundInput.ProtoReflect.Descriptor instead.
+func (*GiftCardRefundInput) Descriptor() ([]byte, []int) {
+	return file_cafe_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GiftCardRefundInput) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *GiftCardRefundInput) GetAmount() uint32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+var File_cafe_proto protoreflect.FileDescriptor
+
+var file_cafe_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x74, 0x65,
+	0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x1a, 0x1b, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x37, 0x0a, 0x04, 0x4d,
+	0x65, 0x6e, 0x75, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e,
+	0x63, 0x61, 0x66, 0x65, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x22, 0xed, 0x01, 0x0a, 0x08, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1c, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66,
+	0x65, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x4b, 0x0a, 0x0f, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61,
+	0x66, 0x65, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x0e, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x11, 0x4d, 0x65, 0x6e, 0x75, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x69, 0x6e,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d,
+	0x61, 0x78, 0x12, 0x3b, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c,
+	0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x22,
+	0x43, 0x0a, 0x0c, 0x4d, 0x65, 0x6e, 0x75, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x64, 0x65, 0x6c,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x44,
+	0x65, 0x6c, 0x74, 0x61, 0x22, 0x29, 0x0a, 0x13, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x4d, 0x0a, 0x19, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x41, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xe1,
+	0x01, 0x0a, 0x0d, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c,
+	0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65,
+	0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x44, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c,
+	0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x6e,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x09, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x62, 0x0a, 0x15, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x6e, 0x65, 0x49,
+	0x74, 0x65, 0x6d, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x64,
+	0x65, 0x6c, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x22, 0x82, 0x02, 0x0a, 0x0a, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x34, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f,
+	0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x6e, 0x65, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x69,
+	0x66, 0x74, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67,
+	0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x74, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f,
+	0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x54, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x52, 0x07, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61,
+	0x78, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x6b, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x6d, 0x61, 0x6b, 0x65, 0x73, 0x22, 0x67, 0x0a, 0x06, 0x54,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f,
+	0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x54, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x78, 0x0a, 0x11, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72,
+	0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x54, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x22, 0x0d,
+	0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xa7, 0x06,
+	0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x6f, 0x70, 0x65, 0x6e, 0x12, 0x3d, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x11, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x07, 0x6c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69,
+	0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x6f, 0x79, 0x61,
+	0x6c, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x07, 0x6c, 0x6f, 0x79, 0x61, 0x6c,
+	0x74, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6c, 0x6f, 0x79, 0x61,
+	0x6c, 0x74, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x34, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74,
+	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73,
+	0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69,
+	0x6e, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x07, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x12,
+	0x3c, 0x0a, 0x07, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61,
+	0x66, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x07, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x6c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x66,
+	0x6f, 0x72, 0x67, 0x6f, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x67, 0x6f, 0x74, 0x74, 0x65,
+	0x6e, 0x12, 0x46, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x12, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f,
+	0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x08, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x60, 0x0a, 0x0d, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x39, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x65,
+	0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08, 0x04, 0x10,
+	0x05, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0x12, 0x0a, 0x10, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x7c, 0x0a, 0x10, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x30, 0x0a, 0x03, 0x61, 0x64, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74,
+	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x03, 0x61, 0x64,
+	0x64, 0x12, 0x36, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63,
+	0x61, 0x66, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0x41, 0x0a, 0x16, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x65, 0x78, 0x74, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64,
+	0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x47, 0x0a, 0x17,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x65, 0x78,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x75, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x75, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c,
+	0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65,
+	0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x22, 0xa5, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x35, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72,
+	0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x6b, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x6d, 0x61, 0x6b, 0x65, 0x73, 0x12,
+	0x3b, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63,
+	0x61, 0x66, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x7e, 0x0a, 0x0e,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xe5, 0x01, 0x0a,
+	0x14, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x6e,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x74, 0x65, 0x6d, 0x70,
+	0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61,
+	0x6b, 0x65, 0x5f, 0x6f, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x6d,
+	0x61, 0x6b, 0x65, 0x4f, 0x66, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x61, 0x6b, 0x65, 0x5f,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
+	0x6d, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x6d, 0x61, 0x6b, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x65, 0x6d,
+	0x61, 0x6b, 0x65, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72,
+	0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c,
+	0x69, 0x6e, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x6b, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x6d, 0x61, 0x6b, 0x65, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x8a, 0x01, 0x0a, 0x1b,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x3f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x27, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66,
+	0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74,
+	0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x19, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x22, 0x83, 0x01, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a,
+	0x03, 0x61, 0x64, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x65, 0x6d,
+	0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x03, 0x61, 0x64, 0x64, 0x12,
+	0x36, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66,
+	0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0xaf, 0x01, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6f, 0x70, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e,
+	0x12, 0x3b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66,
+	0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69,
+	0x6e, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x61, 0x64, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x61, 0x64, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x4e, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x12, 0x39, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c,
+	0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0xb6, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x64,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x64,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x22, 0xae, 0x01, 0x0a, 0x14, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x38, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61,
+	0x66, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0e, 0x65, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x65, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x64, 0x57, 0x61, 0x69, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x12, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0x6c, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x05, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f,
+	0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x22, 0x19, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x3b,
+	0x0a, 0x1c, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0x3c, 0x0a, 0x1d, 0x46,
+	0x69, 0x6e, 0x64, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x1c, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x69, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x27, 0x0a, 0x0f,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x1b,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x45, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x39, 0x0a, 0x07, 0x70, 0x72, 0x6f,
+	0x67, 0x72, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x65, 0x6d,
+	0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4c, 0x6f, 0x79,
+	0x61, 0x6c, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x07, 0x70, 0x72, 0x6f,
+	0x67, 0x72, 0x61, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0xa4, 0x03, 0x0a, 0x0e, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x61, 0x6d, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x0a,
+	0x0f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x69, 0x74, 0x65, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x50, 0x65,
+	0x72, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x50, 0x65, 0x72, 0x44, 0x6f, 0x6c, 0x6c, 0x61,
+	0x72, 0x12, 0x44, 0x0a, 0x0b, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x52, 0x0b, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x12, 0x41, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x65,
+	0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4c, 0x6f,
+	0x79, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x05, 0x74, 0x69,
+	0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x65, 0x6d, 0x70,
+	0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4c, 0x6f, 0x79, 0x61,
+	0x6c, 0x74, 0x79, 0x54, 0x69, 0x65, 0x72, 0x52, 0x05, 0x74, 0x69, 0x65, 0x72, 0x73, 0x12, 0x2c,
+	0x0a, 0x12, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f,
+	0x64, 0x61, 0x79, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x44, 0x61, 0x79, 0x73, 0x12, 0x28, 0x0a, 0x10,
+	0x74, 0x69, 0x65, 0x72, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x64, 0x61, 0x79, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x74, 0x69, 0x65, 0x72, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x44, 0x61, 0x79, 0x73, 0x22, 0x5f, 0x0a, 0x11, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74,
+	0x79, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x74, 0x65, 0x6d, 0x70,
+	0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x8d, 0x02, 0x0a, 0x10, 0x4c, 0x6f, 0x79, 0x61,
+	0x6c, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c,
+	0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65,
+	0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x65, 0x6e, 0x64, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x04, 0x65, 0x6e, 0x64, 0x73, 0x22, 0x51, 0x0a, 0x0b, 0x4c, 0x6f, 0x79, 0x61, 0x6c,
+	0x74, 0x79, 0x54, 0x69, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70,
+	0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x73, 0x0a, 0x20, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x55, 0x0a, 0x21, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x6f, 0x79, 0x61, 0x6c,
+	0x74, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x59, 0x0a, 0x21, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x22, 0x70, 0x0a, 0x20, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x6f, 0x79,
+	0x61, 0x6c, 0x74, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x22, 0xa6, 0x02, 0x0a, 0x1a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x2f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61,
+	0x66, 0x65, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x6f, 0x79, 0x61, 0x6c,
+	0x74, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65,
+	0x71, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x43, 0x0a, 0x13,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0x87, 0x01, 0x0a, 0x0e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c,
+	0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x22, 0xc0, 0x01, 0x0a, 0x0e,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x47, 0x0a, 0x07, 0x6c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c,
+	0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x07, 0x6c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x4f, 0x0a,
+	0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f,
+	0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x6f,
+	0x79, 0x61, 0x6c, 0x74, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x60,
+	0x0a, 0x0d, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x39, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d,
+	0x22, 0x33, 0x0a, 0x14, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x67,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0x5e, 0x0a, 0x14, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0xf1, 0x01, 0x0a, 0x14, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x66, 0x6f, 0x75,
+	0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x5f, 0x73, 0x63, 0x72, 0x75, 0x62, 0x62, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x53, 0x63, 0x72, 0x75, 0x62, 0x62, 0x65, 0x64,
+	0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x72, 0x65, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x64, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x22, 0x54, 0x0a, 0x15, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x61, 0x75, 0x64, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63,
+	0x61, 0x66, 0x65, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x45, 0x72, 0x61, 0x73,
+	0x75, 0x72, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x05, 0x61, 0x75, 0x64, 0x69, 0x74, 0x22,
+	0x25, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75,
+	0x74, 0x68, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75,
+	0x74, 0x68, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x63, 0x0a, 0x15, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x4c, 0x0a, 0x16, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x61, 0x0a, 0x13, 0x43, 0x61, 0x70,
+	0x74, 0x75, 0x72, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x12, 0x32, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63,
+	0x61, 0x66, 0x65, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x16, 0x0a, 0x14,
+	0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0x46, 0x0a, 0x10, 0x56, 0x6f, 0x69, 0x64, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x6d, 0x70,
+	0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x13, 0x0a, 0x11,
+	0x56, 0x6f, 0x69, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0x67, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x32,
+	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66,
+	0x65, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x1c, 0x0a, 0x1a, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x63, 0x0a, 0x13, 0x52, 0x65, 0x64, 0x65,
+	0x65, 0x6d, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2e, 0x0a,
+	0x14, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x63, 0x0a,
+	0x13, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x47, 0x69, 0x66, 0x74,
+	0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x18, 0x52,
+	0x65, 0x64, 0x65, 0x65, 0x6d, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1c, 0x0a,
+	0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x33,
+	0x0a, 0x19, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x22, 0x67, 0x0a, 0x19, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x6f,
+	0x79, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x1c, 0x0a, 0x1a,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xd4, 0x01, 0x0a, 0x15, 0x41,
+	0x64, 0x64, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x34, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x65, 0x6d, 0x70,
+	0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63,
+	0x61, 0x66, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x07, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70,
+	0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x4a, 0x04, 0x08, 0x02, 0x10,
+	0x03, 0x22, 0x79, 0x0a, 0x1a, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x45, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x1d, 0x0a, 0x1b,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x2b, 0x0a, 0x13, 0x46,
+	0x6f, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x49, 0x0a, 0x14, 0x46, 0x6f, 0x72, 0x67,
+	0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x73, 0x22, 0x2d, 0x0a, 0x10, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x49, 0x0a, 0x11, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x22, 0x32, 0x0a,
+	0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x22, 0x40, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x73, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x22, 0x59, 0x0a, 0x1a, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x12, 0x3b, 0x0a, 0x05, 0x61, 0x75, 0x64, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61,
+	0x66, 0x65, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x45, 0x72, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x05, 0x61, 0x75, 0x64, 0x69, 0x74, 0x22, 0x1d,
+	0x0a, 0x1b, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x30, 0x0a,
+	0x16, 0x41, 0x64, 0x64, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22,
+	0x77, 0x0a, 0x0d, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x0e, 0x47, 0x69, 0x66,
+	0x74, 0x43, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x34, 0x0a,
+	0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x22, 0x2c, 0x0a,
+	0x12, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x54, 0x6f, 0x70, 0x55, 0x70, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4b, 0x0a, 0x13, 0x47,
+	0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x48, 0x0a, 0x14, 0x47, 0x69, 0x66, 0x74,
+	0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x22, 0x4b, 0x0a, 0x13, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x2a,
+	0x59, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x14, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x4f, 0x44,
+	0x55, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x4f, 0x4f, 0x44, 0x10, 0x01, 0x12,
+	0x19, 0x0a, 0x15, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x42, 0x45, 0x56, 0x45, 0x52, 0x41, 0x47, 0x45, 0x10, 0x02, 0x2a, 0x76, 0x0a, 0x0a, 0x54, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x45, 0x4e, 0x44,
+	0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
+	0x00, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x43, 0x41, 0x52, 0x44, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x45, 0x4e, 0x44, 0x45,
+	0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x49, 0x46, 0x54, 0x5f, 0x43, 0x41, 0x52, 0x44,
+	0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x4c, 0x4f, 0x59, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x53,
+	0x10, 0x03, 0x2a, 0xdf, 0x01, 0x0a, 0x12, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x1c, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x50, 0x41, 0x49, 0x44, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x23,
+	0x0a, 0x1f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45,
+	0x44, 0x10, 0x04, 0x12, 0x1f, 0x0a, 0x1b, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x59,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x4f, 0x49, 0x44,
+	0x45, 0x44, 0x10, 0x05, 0x2a, 0x9c, 0x01, 0x0a, 0x12, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x6f,
+	0x79, 0x61, 0x6c, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x1c, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x59, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x20, 0x0a,
+	0x1c, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x59, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x21, 0x0a, 0x1d, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x59, 0x41, 0x4c, 0x54, 0x59,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x52, 0x45, 0x44, 0x49, 0x54, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x59, 0x41,
+	0x4c, 0x54, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0x03, 0x2a, 0x84, 0x02, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25,
+	0x0a, 0x21, 0x53, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
+	0x49, 0x54, 0x45, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x53, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x27, 0x0a, 0x23,
+	0x53, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x49, 0x54,
+	0x45, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45,
+	0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x27, 0x0a, 0x23, 0x53,
+	0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x49, 0x54, 0x45,
+	0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x52, 0x45, 0x4d, 0x41, 0x44, 0x45, 0x10, 0x05, 0x2a, 0xf4, 0x02, 0x0a, 0x1e, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a,
+	0x29, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x59, 0x41, 0x4c, 0x54,
+	0x59, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x36, 0x0a, 0x32,
+	0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x59, 0x41, 0x4c, 0x54, 0x59,
+	0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x42, 0x41, 0x4c, 0x41, 0x4e,
+	0x43, 0x45, 0x10, 0x01, 0x12, 0x2c, 0x0a, 0x28, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52,
+	0x5f, 0x4c, 0x4f, 0x59, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x41, 0x52, 0x4e, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x2e, 0x0a, 0x2a, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x5f, 0x4c,
+	0x4f, 0x59, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x44, 0x45, 0x45, 0x4d, 0x45, 0x44,
+	0x10, 0x03, 0x12, 0x2e, 0x0a, 0x2a, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x5f, 0x4c,
+	0x4f, 0x59, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x44,
+	0x10, 0x04, 0x12, 0x2d, 0x0a, 0x29, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x5f, 0x4c,
+	0x4f, 0x59, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10,
+	0x05, 0x12, 0x2e, 0x0a, 0x2a, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x5f, 0x4c, 0x4f,
+	0x59, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x44, 0x4a, 0x55, 0x53, 0x54, 0x45, 0x44, 0x10,
+	0x06, 0x32, 0xdd, 0x1b, 0x0a, 0x04, 0x43, 0x61, 0x66, 0x65, 0x12, 0x44, 0x0a, 0x05, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f,
+	0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x1a, 0x1c, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61,
+	0x66, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00,
+	0x12, 0x50, 0x0a, 0x1c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x4a, 0x0a, 0x10, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c,
+	0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65,
+	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x59,
+	0x0a, 0x18, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x23, 0x2e, 0x74, 0x65, 0x6d,
+	0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x11, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x21,
+	0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65,
+	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63,
+	0x61, 0x66, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x00, 0x12, 0x56, 0x0a, 0x11, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4d, 0x6f,
+	0x64, 0x69, 0x66, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x65, 0x6d, 0x70,
+	0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x1c, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x67, 0x6f, 0x74,
+	0x74, 0x65, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x09, 0x4d,
+	0x65, 0x6e, 0x75, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x15, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61,
+	0x66, 0x65, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x11, 0x4d, 0x65, 0x6e,
+	0x75, 0x49, 0x74, 0x65, 0x6d, 0x41, 0x64, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x19,
+	0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65,
+	0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x1a, 0x15, 0x2e, 0x74, 0x65, 0x6d, 0x70,
+	0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4d, 0x65, 0x6e, 0x75,
+	0x22, 0x00, 0x12, 0x4a, 0x0a, 0x14, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x74, 0x65, 0x6d,
+	0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4d, 0x65, 0x6e,
+	0x75, 0x49, 0x74, 0x65, 0x6d, 0x1a, 0x15, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c,
+	0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x22, 0x00, 0x12, 0x55,
+	0x0a, 0x14, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x15, 0x2e, 0x74,
+	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4d,
+	0x65, 0x6e, 0x75, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x1a, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65,
+	0x6d, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x2a, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f,
+	0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a,
+	0x15, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66,
+	0x65, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x11, 0x4d, 0x65, 0x6e, 0x75,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x15, 0x2e,
+	0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e,
+	0x4d, 0x65, 0x6e, 0x75, 0x1a, 0x15, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69,
+	0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x22, 0x00, 0x12, 0x41, 0x0a,
+	0x0d, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x6e, 0x0a, 0x17, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x4e, 0x65, 0x78, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x27, 0x2e, 0x74, 0x65,
+	0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x65, 0x78, 0x74, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x1a, 0x28, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69,
+	0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00,
+	0x12, 0x59, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x22, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61,
+	0x66, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69,
+	0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x17, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x23,
+	0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x1c, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c,
+	0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f,
+	0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x1a, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f,
+	0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69,
+	0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x17, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x28, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x1a, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0c, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x22, 0x2e, 0x74, 0x65, 0x6d,
+	0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x12, 0x22, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f,
+	0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x58, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x23, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69,
+	0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x21, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x45, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x12, 0x2c, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61,
+	0x66, 0x65, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x6f, 0x79, 0x61, 0x6c,
+	0x74, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x45, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x83, 0x01, 0x0a, 0x21, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x2d,
+	0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65,
+	0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x2d, 0x2e,
+	0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x00, 0x12, 0x8c,
+	0x01, 0x0a, 0x21, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x6f, 0x79, 0x61, 0x6c,
+	0x74, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x31, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69,
+	0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c,
+	0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x64, 0x65,
+	0x65, 0x6d, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x32, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72,
+	0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x64, 0x65, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x89, 0x01,
+	0x0a, 0x22, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74,
+	0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x32, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69,
+	0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c,
+	0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x2d, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f,
+	0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x00, 0x12, 0x87, 0x01, 0x0a, 0x21, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x31, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66,
+	0x65, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74,
+	0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x1a, 0x2d, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e,
+	0x63, 0x61, 0x66, 0x65, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x6f, 0x79,
+	0x61, 0x6c, 0x74, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x13, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e,
+	0x63, 0x61, 0x66, 0x65, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x13, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x24, 0x2e, 0x74,
+	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e,
+	0x63, 0x61, 0x66, 0x65, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x14, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x25, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c,
+	0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x62,
+	0x0a, 0x0f, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x45, 0x72, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x12, 0x25, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63,
+	0x61, 0x66, 0x65, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x45, 0x72, 0x61, 0x73,
+	0x75, 0x72, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f,
+	0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x22, 0x00, 0x12, 0x44, 0x0a, 0x08, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x12, 0x1e,
+	0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65,
+	0x2e, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x13, 0x47, 0x69, 0x66, 0x74,
+	0x43, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72,
+	0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61,
+	0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x13, 0x47, 0x69,
+	0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x54, 0x6f, 0x70, 0x55, 0x70, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x23, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63,
+	0x61, 0x66, 0x65, 0x2e, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x54, 0x6f, 0x70, 0x55,
+	0x70, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x14, 0x47, 0x69, 0x66,
+	0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x24, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63,
+	0x61, 0x66, 0x65, 0x2e, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x64, 0x65,
+	0x65, 0x6d, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x25, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72,
+	0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00,
+	0x12, 0x5f, 0x0a, 0x14, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f,
+	0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x47, 0x69, 0x66, 0x74, 0x43,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1f,
+	0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65,
+	0x2e, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x00, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6f,
+	0x72, 0x61, 0x6c, 0x2d, 0x63, 0x61, 0x66, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cafe_proto_rawDescOnce sync.Once
+	file_cafe_proto_rawDescData = file_cafe_proto_rawDesc
+)
+
+func file_cafe_proto_rawDescGZIP() []byte {
+	file_cafe_proto_rawDescOnce.Do(func() {
+		file_cafe_proto_rawDescData = protoimpl.X.CompressGZIP(file_cafe_proto_rawDescData)
+	})
+	return file_cafe_proto_rawDescData
+}
+
+var file_cafe_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_cafe_proto_msgTypes = make([]protoimpl.MessageInfo, 90)
+var file_cafe_proto_goTypes = []interface{}{
+	(ProductType)(0),                          // 0: temporalio.cafe.ProductType
+	(TenderType)(0),                           // 1: temporalio.cafe.TenderType
+	(OrderPaymentStatus)(0),                   // 2: temporalio.cafe.OrderPaymentStatus
+	(OrderLoyaltyStatus)(0),                   // 3: temporalio.cafe.OrderLoyaltyStatus
+	(StationOrderItemStatus)(0),               // 4: temporalio.cafe.StationOrderItemStatus
+	(CustomerLoyaltyTransactionType)(0),       // 5: temporalio.cafe.CustomerLoyaltyTransactionType
+	(*Menu)(nil),                              // 6: temporalio.cafe.Menu
+	(*MenuItem)(nil),                          // 7: temporalio.cafe.MenuItem
+	(*MenuModifierGroup)(nil),                 // 8: temporalio.cafe.MenuModifierGroup
+	(*MenuModifier)(nil),                      // 9: temporalio.cafe.MenuModifier
+	(*MenuItemRemoveInput)(nil),               // 10: temporalio.cafe.MenuItemRemoveInput
+	(*MenuItemAvailabilityInput)(nil),         // 11: temporalio.cafe.MenuItemAvailabilityInput
+	(*OrderLineItem)(nil),                     // 12: temporalio.cafe.OrderLineItem
+	(*OrderLineItemModifier)(nil),             // 13: temporalio.cafe.OrderLineItemModifier
+	(*OrderInput)(nil),                        // 14: temporalio.cafe.OrderInput
+	(*Tender)(nil),                            // 15: temporalio.cafe.Tender
+	(*OrderTenderStatus)(nil),                 // 16: temporalio.cafe.OrderTenderStatus
+	(*OrderResult)(nil),                       // 17: temporalio.cafe.OrderResult
+	(*OrderStatus)(nil),                       // 18: temporalio.cafe.OrderStatus
+	(*OrderCancelInput)(nil),                  // 19: temporalio.cafe.OrderCancelInput
+	(*OrderModifyInput)(nil),                  // 20: temporalio.cafe.OrderModifyInput
+	(*OrderSequenceNextInput)(nil),            // 21: temporalio.cafe.OrderSequenceNextInput
+	(*OrderSequenceNextResult)(nil),           // 22: temporalio.cafe.OrderSequenceNextResult
+	(*StationRoute)(nil),                      // 23: temporalio.cafe.StationRoute
+	(*StationRouting)(nil),                    // 24: temporalio.cafe.StationRouting
+	(*StationOptions)(nil),                    // 25: temporalio.cafe.StationOptions
+	(*StationOrderLineItem)(nil),              // 26: temporalio.cafe.StationOrderLineItem
+	(*StationOrderInput)(nil),                 // 27: temporalio.cafe.StationOrderInput
+	(*StationOrderItemStatusInput)(nil),       // 28: temporalio.cafe.StationOrderItemStatusInput
+	(*StationOrderPriorityInput)(nil),         // 29: temporalio.cafe.StationOrderPriorityInput
+	(*StationOrderItemsUpdate)(nil),           // 30: temporalio.cafe.StationOrderItemsUpdate
+	(*StationOrderStatus)(nil),                // 31: temporalio.cafe.StationOrderStatus
+	(*StationOrderResult)(nil),                // 32: temporalio.cafe.StationOrderResult
+	(*StationQueueInput)(nil),                 // 33: temporalio.cafe.StationQueueInput
+	(*StationQueueOrder)(nil),                 // 34: temporalio.cafe.StationQueueOrder
+	(*StationQueuePosition)(nil),              // 35: temporalio.cafe.StationQueuePosition
+	(*StationQueueStatus)(nil),                // 36: temporalio.cafe.StationQueueStatus
+	(*QueueStationOrderInput)(nil),            // 37: temporalio.cafe.QueueStationOrderInput
+	(*QueueStationOrderResult)(nil),           // 38: temporalio.cafe.QueueStationOrderResult
+	(*FindClosedStationOrdersInput)(nil),      // 39: temporalio.cafe.FindClosedStationOrdersInput
+	(*FindClosedStationOrdersResult)(nil),     // 40: temporalio.cafe.FindClosedStationOrdersResult
+	(*CustomerLoyaltyPointsBalance)(nil),      // 41: temporalio.cafe.CustomerLoyaltyPointsBalance
+	(*CustomerLoyaltyPointsEarned)(nil),       // 42: temporalio.cafe.CustomerLoyaltyPointsEarned
+	(*LoyaltyProgram)(nil),                    // 43: temporalio.cafe.LoyaltyProgram
+	(*LoyaltyMultiplier)(nil),                 // 44: temporalio.cafe.LoyaltyMultiplier
+	(*LoyaltyPromotion)(nil),                  // 45: temporalio.cafe.LoyaltyPromotion
+	(*LoyaltyTier)(nil),                       // 46: temporalio.cafe.LoyaltyTier
+	(*CustomerLoyaltyPointsRedeemInput)(nil),  // 47: temporalio.cafe.CustomerLoyaltyPointsRedeemInput
+	(*CustomerLoyaltyPointsRedeemResult)(nil), // 48: temporalio.cafe.CustomerLoyaltyPointsRedeemResult
+	(*CustomerLoyaltyPointsReleaseInput)(nil), // 49: temporalio.cafe.CustomerLoyaltyPointsReleaseInput
+	(*CustomerLoyaltyPointsAdjustInput)(nil),  // 50: temporalio.cafe.CustomerLoyaltyPointsAdjustInput
+	(*CustomerLoyaltyTransaction)(nil),        // 51: temporalio.cafe.CustomerLoyaltyTransaction
+	(*CustomerLedgerInput)(nil),               // 52: temporalio.cafe.CustomerLedgerInput
+	(*CustomerLedger)(nil),                    // 53: temporalio.cafe.CustomerLedger
+	(*CustomerStatus)(nil),                    // 54: temporalio.cafe.CustomerStatus
+	(*CustomerInput)(nil),                     // 55: temporalio.cafe.CustomerInput
+	(*CustomerForgetResult)(nil),              // 56: temporalio.cafe.CustomerForgetResult
+	(*CustomerErasureInput)(nil),              // 57: temporalio.cafe.CustomerErasureInput
+	(*CustomerErasureAudit)(nil),              // 58: temporalio.cafe.CustomerErasureAudit
+	(*CustomerErasureResult)(nil),             // 59: temporalio.cafe.CustomerErasureResult
+	(*Payment)(nil),                           // 60: temporalio.cafe.Payment
+	(*AuthorizePaymentInput)(nil),             // 61: temporalio.cafe.AuthorizePaymentInput
+	(*AuthorizePaymentResult)(nil),            // 62: temporalio.cafe.AuthorizePaymentResult
+	(*CapturePaymentInput)(nil),               // 63: temporalio.cafe.CapturePaymentInput
+	(*CapturePaymentResult)(nil),              // 64: temporalio.cafe.CapturePaymentResult
+	(*VoidPaymentInput)(nil),                  // 65: temporalio.cafe.VoidPaymentInput
+	(*VoidPaymentResult)(nil),                 // 66: temporalio.cafe.VoidPaymentResult
+	(*ProcessPaymentRefundInput)(nil),         // 67: temporalio.cafe.ProcessPaymentRefundInput
+	(*ProcessPaymentRefundResult)(nil),        // 68: temporalio.cafe.ProcessPaymentRefundResult
+	(*RedeemGiftCardInput)(nil),               // 69: temporalio.cafe.RedeemGiftCardInput
+	(*RedeemGiftCardResult)(nil),              // 70: temporalio.cafe.RedeemGiftCardResult
+	(*RefundGiftCardInput)(nil),               // 71: temporalio.cafe.RefundGiftCardInput
+	(*RefundGiftCardResult)(nil),              // 72: temporalio.cafe.RefundGiftCardResult
+	(*RedeemLoyaltyPointsInput)(nil),          // 73: temporalio.cafe.RedeemLoyaltyPointsInput
+	(*RedeemLoyaltyPointsResult)(nil),         // 74: temporalio.cafe.RedeemLoyaltyPointsResult
+	(*ReleaseLoyaltyPointsInput)(nil),         // 75: temporalio.cafe.ReleaseLoyaltyPointsInput
+	(*ReleaseLoyaltyPointsResult)(nil),        // 76: temporalio.cafe.ReleaseLoyaltyPointsResult
+	(*AddLoyaltyPointsInput)(nil),             // 77: temporalio.cafe.AddLoyaltyPointsInput
+	(*ArchiveCustomerLedgerInput)(nil),        // 78: temporalio.cafe.ArchiveCustomerLedgerInput
+	(*ArchiveCustomerLedgerResult)(nil),       // 79: temporalio.cafe.ArchiveCustomerLedgerResult
+	(*ForgetCustomerInput)(nil),               // 80: temporalio.cafe.ForgetCustomerInput
+	(*ForgetCustomerResult)(nil),              // 81: temporalio.cafe.ForgetCustomerResult
+	(*ForgetOrderInput)(nil),                  // 82: temporalio.cafe.ForgetOrderInput
+	(*ForgetOrderResult)(nil),                 // 83: temporalio.cafe.ForgetOrderResult
+	(*DeleteCustomerRecordsInput)(nil),        // 84: temporalio.cafe.DeleteCustomerRecordsInput
+	(*DeleteCustomerRecordsResult)(nil),       // 85: temporalio.cafe.DeleteCustomerRecordsResult
+	(*RecordCustomerErasureInput)(nil),        // 86: temporalio.cafe.RecordCustomerErasureInput
+	(*RecordCustomerErasureResult)(nil),       // 87: temporalio.cafe.RecordCustomerErasureResult
+	(*AddLoyaltyPointsResult)(nil),            // 88: temporalio.cafe.AddLoyaltyPointsResult
+	(*GiftCardInput)(nil),                     // 89: temporalio.cafe.GiftCardInput
+	(*GiftCardStatus)(nil),                    // 90: temporalio.cafe.GiftCardStatus
+	(*GiftCardTopUpInput)(nil),                // 91: temporalio.cafe.GiftCardTopUpInput
+	(*GiftCardRedeemInput)(nil),               // 92: temporalio.cafe.GiftCardRedeemInput
+	(*GiftCardRedeemResult)(nil),              // 93: temporalio.cafe.GiftCardRedeemResult
+	(*GiftCardRefundInput)(nil),               // 94: temporalio.cafe.GiftCardRefundInput
+	nil,                                       // 95: temporalio.cafe.OrderStatus.StationsEntry
+	(*durationpb.Duration)(nil),               // 96: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),             // 97: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                     // 98: google.protobuf.Empty
+}
+var file_cafe_proto_depIdxs = []int32{
+	7,  // 0: temporalio.cafe.Menu.items:type_name -> temporalio.cafe.MenuItem
+	0,  // 1: temporalio.cafe.MenuItem.type:type_name -> temporalio.cafe.ProductType
+	8,  // 2: temporalio.cafe.MenuItem.modifier_groups:type_name -> temporalio.cafe.MenuModifierGroup
+	9,  // 3: temporalio.cafe.MenuModifierGroup.modifiers:type_name -> temporalio.cafe.MenuModifier
+	0,  // 4: temporalio.cafe.OrderLineItem.type:type_name -> temporalio.cafe.ProductType
+	13, // 5: temporalio.cafe.OrderLineItem.modifiers:type_name -> temporalio.cafe.OrderLineItemModifier
+	12, // 6: temporalio.cafe.OrderInput.items:type_name -> temporalio.cafe.OrderLineItem
+	15, // 7: temporalio.cafe.OrderInput.tenders:type_name -> temporalio.cafe.Tender
+	1,  // 8: temporalio.cafe.Tender.type:type_name -> temporalio.cafe.TenderType
+	1,  // 9: temporalio.cafe.OrderTenderStatus.type:type_name -> temporalio.cafe.TenderType
+	2,  // 10: temporalio.cafe.OrderStatus.payment:type_name -> temporalio.cafe.OrderPaymentStatus
+	3,  // 11: temporalio.cafe.OrderStatus.loyalty:type_name -> temporalio.cafe.OrderLoyaltyStatus
+	12, // 12: temporalio.cafe.OrderStatus.items:type_name -> temporalio.cafe.OrderLineItem
+	12, // 13: temporalio.cafe.OrderStatus.refunds:type_name -> temporalio.cafe.OrderLineItem
+	16, // 14: temporalio.cafe.OrderStatus.tenders:type_name -> temporalio.cafe.OrderTenderStatus
+	95, // 15: temporalio.cafe.OrderStatus.stations:type_name -> temporalio.cafe.OrderStatus.StationsEntry
+	12, // 16: temporalio.cafe.OrderModifyInput.add:type_name -> temporalio.cafe.OrderLineItem
+	12, // 17: temporalio.cafe.OrderModifyInput.remove:type_name -> temporalio.cafe.OrderLineItem
+	0,  // 18: temporalio.cafe.StationRoute.type:type_name -> temporalio.cafe.ProductType
+	23, // 19: temporalio.cafe.StationRouting.routes:type_name -> temporalio.cafe.StationRoute
+	25, // 20: temporalio.cafe.StationRouting.stations:type_name -> temporalio.cafe.StationOptions
+	96, // 21: temporalio.cafe.StationOptions.item_time:type_name -> google.protobuf.Duration
+	4,  // 22: temporalio.cafe.StationOrderLineItem.status:type_name -> temporalio.cafe.StationOrderItemStatus
+	12, // 23: temporalio.cafe.StationOrderInput.items:type_name -> temporalio.cafe.OrderLineItem
+	4,  // 24: temporalio.cafe.StationOrderItemStatusInput.status:type_name -> temporalio.cafe.StationOrderItemStatus
+	12, // 25: temporalio.cafe.StationOrderItemsUpdate.add:type_name -> temporalio.cafe.OrderLineItem
+	12, // 26: temporalio.cafe.StationOrderItemsUpdate.remove:type_name -> temporalio.cafe.OrderLineItem
+	26, // 27: temporalio.cafe.StationOrderStatus.items:type_name -> temporalio.cafe.StationOrderLineItem
+	25, // 28: temporalio.cafe.StationQueueInput.options:type_name -> temporalio.cafe.StationOptions
+	34, // 29: temporalio.cafe.StationQueuePosition.order:type_name -> temporalio.cafe.StationQueueOrder
+	96, // 30: temporalio.cafe.StationQueuePosition.estimated_wait:type_name -> google.protobuf.Duration
+	35, // 31: temporalio.cafe.StationQueueStatus.orders:type_name -> temporalio.cafe.StationQueuePosition
+	34, // 32: temporalio.cafe.QueueStationOrderInput.order:type_name -> temporalio.cafe.StationQueueOrder
+	97, // 33: temporalio.cafe.CustomerLoyaltyPointsBalance.expires:type_name -> google.protobuf.Timestamp
+	43, // 34: temporalio.cafe.CustomerLoyaltyPointsEarned.program:type_name -> temporalio.cafe.LoyaltyProgram
+	44, // 35: temporalio.cafe.LoyaltyProgram.multipliers:type_name -> temporalio.cafe.LoyaltyMultiplier
+	45, // 36: temporalio.cafe.LoyaltyProgram.promotions:type_name -> temporalio.cafe.LoyaltyPromotion
+	46, // 37: temporalio.cafe.LoyaltyProgram.tiers:type_name -> temporalio.cafe.LoyaltyTier
+	0,  // 38: temporalio.cafe.LoyaltyMultiplier.type:type_name -> temporalio.cafe.ProductType
+	0,  // 39: temporalio.cafe.LoyaltyPromotion.type:type_name -> temporalio.cafe.ProductType
+	97, // 40: temporalio.cafe.LoyaltyPromotion.starts:type_name -> google.protobuf.Timestamp
+	97, // 41: temporalio.cafe.LoyaltyPromotion.ends:type_name -> google.protobuf.Timestamp
+	5,  // 42: temporalio.cafe.CustomerLoyaltyTransaction.type:type_name -> temporalio.cafe.CustomerLoyaltyTransactionType
+	97, // 43: temporalio.cafe.CustomerLoyaltyTransaction.time:type_name -> google.protobuf.Timestamp
+	51, // 44: temporalio.cafe.CustomerLedger.entries:type_name -> temporalio.cafe.CustomerLoyaltyTransaction
+	41, // 45: temporalio.cafe.CustomerStatus.loyalty:type_name -> temporalio.cafe.CustomerLoyaltyPointsBalance
+	51, // 46: temporalio.cafe.CustomerStatus.transactions:type_name -> temporalio.cafe.CustomerLoyaltyTransaction
+	43, // 47: temporalio.cafe.CustomerInput.program:type_name -> temporalio.cafe.LoyaltyProgram
+	97, // 48: temporalio.cafe.CustomerErasureAudit.time:type_name -> google.protobuf.Timestamp
+	58, // 49: temporalio.cafe.CustomerErasureResult.audit:type_name -> temporalio.cafe.CustomerErasureAudit
+	60, // 50: temporalio.cafe.AuthorizePaymentResult.payment:type_name -> temporalio.cafe.Payment
+	60, // 51: temporalio.cafe.CapturePaymentInput.payment:type_name -> temporalio.cafe.Payment
+	60, // 52: temporalio.cafe.VoidPaymentInput.payment:type_name -> temporalio.cafe.Payment
+	60, // 53: temporalio.cafe.ProcessPaymentRefundInput.payment:type_name -> temporalio.cafe.Payment
+	12, // 54: temporalio.cafe.AddLoyaltyPointsInput.items:type_name -> temporalio.cafe.OrderLineItem
+	12, // 55: temporalio.cafe.AddLoyaltyPointsInput.refunds:type_name -> temporalio.cafe.OrderLineItem
+	51, // 56: temporalio.cafe.ArchiveCustomerLedgerInput.entries:type_name -> temporalio.cafe.CustomerLoyaltyTransaction
+	58, // 57: temporalio.cafe.RecordCustomerErasureInput.audit:type_name -> temporalio.cafe.CustomerErasureAudit
+	97, // 58: temporalio.cafe.GiftCardInput.expires:type_name -> google.protobuf.Timestamp
+	97, // 59: temporalio.cafe.GiftCardStatus.expires:type_name -> google.protobuf.Timestamp
+	31, // 60: temporalio.cafe.OrderStatus.StationsEntry.value:type_name -> temporalio.cafe.StationOrderStatus
+	14, // 61: temporalio.cafe.Cafe.Order:input_type -> temporalio.cafe.OrderInput
+	98, // 62: temporalio.cafe.Cafe.OrderFulfilmentStartedSignal:input_type -> google.protobuf.Empty
+	98, // 63: temporalio.cafe.Cafe.OrderStatusQuery:input_type -> google.protobuf.Empty
+	31, // 64: temporalio.cafe.Cafe.OrderStationStatusSignal:input_type -> temporalio.cafe.StationOrderStatus
+	19, // 65: temporalio.cafe.Cafe.OrderCancelUpdate:input_type -> temporalio.cafe.OrderCancelInput
+	20, // 66: temporalio.cafe.Cafe.OrderModifyUpdate:input_type -> temporalio.cafe.OrderModifyInput
+	98, // 67: temporalio.cafe.Cafe.OrderCustomerForgottenSignal:input_type -> google.protobuf.Empty
+	98, // 68: temporalio.cafe.Cafe.MenuQuery:input_type -> google.protobuf.Empty
+	7,  // 69: temporalio.cafe.Cafe.MenuItemAddUpdate:input_type -> temporalio.cafe.MenuItem
+	7,  // 70: temporalio.cafe.Cafe.MenuItemChangeUpdate:input_type -> temporalio.cafe.MenuItem
+	10, // 71: temporalio.cafe.Cafe.MenuItemRemoveUpdate:input_type -> temporalio.cafe.MenuItemRemoveInput
+	11, // 72: temporalio.cafe.Cafe.MenuItemAvailabilityUpdate:input_type -> temporalio.cafe.MenuItemAvailabilityInput
+	6,  // 73: temporalio.cafe.Cafe.MenuReplaceUpdate:input_type -> temporalio.cafe.Menu
+	98, // 74: temporalio.cafe.Cafe.OrderSequence:input_type -> google.protobuf.Empty
+	21, // 75: temporalio.cafe.Cafe.OrderSequenceNextUpdate:input_type -> temporalio.cafe.OrderSequenceNextInput
+	27, // 76: temporalio.cafe.Cafe.StationOrder:input_type -> temporalio.cafe.StationOrderInput
+	98, // 77: temporalio.cafe.Cafe.StationOrderStatusQuery:input_type -> google.protobuf.Empty
+	28, // 78: temporalio.cafe.Cafe.StationOrderItemStatusUpdate:input_type -> temporalio.cafe.StationOrderItemStatusInput
+	29, // 79: temporalio.cafe.Cafe.StationOrderPriorityUpdate:input_type -> temporalio.cafe.StationOrderPriorityInput
+	30, // 80: temporalio.cafe.Cafe.StationOrderItemsSignal:input_type -> temporalio.cafe.StationOrderItemsUpdate
+	98, // 81: temporalio.cafe.Cafe.StationOrderAdmittedSignal:input_type -> google.protobuf.Empty
+	33, // 82: temporalio.cafe.Cafe.StationQueue:input_type -> temporalio.cafe.StationQueueInput
+	34, // 83: temporalio.cafe.Cafe.StationQueueOrderSignal:input_type -> temporalio.cafe.StationQueueOrder
+	98, // 84: temporalio.cafe.Cafe.StationQueueStatusQuery:input_type -> google.protobuf.Empty
+	42, // 85: temporalio.cafe.Cafe.CustomerLoyaltyPointsEarnedSignal:input_type -> temporalio.cafe.CustomerLoyaltyPointsEarned
+	41, // 86: temporalio.cafe.Cafe.CustomerLoyaltyPointsBalanceQuery:input_type -> temporalio.cafe.CustomerLoyaltyPointsBalance
+	47, // 87: temporalio.cafe.Cafe.CustomerLoyaltyPointsRedeemUpdate:input_type -> temporalio.cafe.CustomerLoyaltyPointsRedeemInput
+	49, // 88: temporalio.cafe.Cafe.CustomerLoyaltyPointsReleaseUpdate:input_type -> temporalio.cafe.CustomerLoyaltyPointsReleaseInput
+	50, // 89: temporalio.cafe.Cafe.CustomerLoyaltyPointsAdjustUpdate:input_type -> temporalio.cafe.CustomerLoyaltyPointsAdjustInput
+	98, // 90: temporalio.cafe.Cafe.CustomerStatusQuery:input_type -> google.protobuf.Empty
+	52, // 91: temporalio.cafe.Cafe.CustomerLedgerQuery:input_type -> temporalio.cafe.CustomerLedgerInput
+	98, // 92: temporalio.cafe.Cafe.CustomerForgetUpdate:input_type -> google.protobuf.Empty
+	57, // 93: temporalio.cafe.Cafe.CustomerErasure:input_type -> temporalio.cafe.CustomerErasureInput
+	89, // 94: temporalio.cafe.Cafe.GiftCard:input_type -> temporalio.cafe.GiftCardInput
+	98, // 95: temporalio.cafe.Cafe.GiftCardStatusQuery:input_type -> google.protobuf.Empty
+	91, // 96: temporalio.cafe.Cafe.GiftCardTopUpUpdate:input_type -> temporalio.cafe.GiftCardTopUpInput
+	92, // 97: temporalio.cafe.Cafe.GiftCardRedeemUpdate:input_type -> temporalio.cafe.GiftCardRedeemInput
+	94, // 98: temporalio.cafe.Cafe.GiftCardRefundUpdate:input_type -> temporalio.cafe.GiftCardRefundInput
+	17, // 99: temporalio.cafe.Cafe.Order:output_type -> temporalio.cafe.OrderResult
+	98, // 100: temporalio.cafe.Cafe.OrderFulfilmentStartedSignal:output_type -> google.protobuf.Empty
+	18, // 101: temporalio.cafe.Cafe.OrderStatusQuery:output_type -> temporalio.cafe.OrderStatus
+	98, // 102: temporalio.cafe.Cafe.OrderStationStatusSignal:output_type -> google.protobuf.Empty
+	18, // 103: temporalio.cafe.Cafe.OrderCancelUpdate:output_type -> temporalio.cafe.OrderStatus
+	18, // 104: temporalio.cafe.Cafe.OrderModifyUpdate:output_type -> temporalio.cafe.OrderStatus
+	98, // 105: temporalio.cafe.Cafe.OrderCustomerForgottenSignal:output_type -> google.protobuf.Empty
+	6,  // 106: temporalio.cafe.Cafe.MenuQuery:output_type -> temporalio.cafe.Menu
+	6,  // 107: temporalio.cafe.Cafe.MenuItemAddUpdate:output_type -> temporalio.cafe.Menu
+	6,  // 108: temporalio.cafe.Cafe.MenuItemChangeUpdate:output_type -> temporalio.cafe.Menu
+	6,  // 109: temporalio.cafe.Cafe.MenuItemRemoveUpdate:output_type -> temporalio.cafe.Menu
+	6,  // 110: temporalio.cafe.Cafe.MenuItemAvailabilityUpdate:output_type -> temporalio.cafe.Menu
+	6,  // 111: temporalio.cafe.Cafe.MenuReplaceUpdate:output_type -> temporalio.cafe.Menu
+	98, // 112: temporalio.cafe.Cafe.OrderSequence:output_type -> google.protobuf.Empty
+	22, // 113: temporalio.cafe.Cafe.OrderSequenceNextUpdate:output_type -> temporalio.cafe.OrderSequenceNextResult
+	32, // 114: temporalio.cafe.Cafe.StationOrder:output_type -> temporalio.cafe.StationOrderResult
+	31, // 115: temporalio.cafe.Cafe.StationOrderStatusQuery:output_type -> temporalio.cafe.StationOrderStatus
+	31, // 116: temporalio.cafe.Cafe.StationOrderItemStatusUpdate:output_type -> temporalio.cafe.StationOrderStatus
+	31, // 117: temporalio.cafe.Cafe.StationOrderPriorityUpdate:output_type -> temporalio.cafe.StationOrderStatus
+	98, // 118: temporalio.cafe.Cafe.StationOrderItemsSignal:output_type -> google.protobuf.Empty
+	98, // 119: temporalio.cafe.Cafe.StationOrderAdmittedSignal:output_type -> google.protobuf.Empty
+	98, // 120: temporalio.cafe.Cafe.StationQueue:output_type -> google.protobuf.Empty
+	98, // 121: temporalio.cafe.Cafe.StationQueueOrderSignal:output_type -> google.protobuf.Empty
+	36, // 122: temporalio.cafe.Cafe.StationQueueStatusQuery:output_type -> temporalio.cafe.StationQueueStatus
+	98, // 123: temporalio.cafe.Cafe.CustomerLoyaltyPointsEarnedSignal:output_type -> google.protobuf.Empty
+	41, // 124: temporalio.cafe.Cafe.CustomerLoyaltyPointsBalanceQuery:output_type -> temporalio.cafe.CustomerLoyaltyPointsBalance
+	48, // 125: temporalio.cafe.Cafe.CustomerLoyaltyPointsRedeemUpdate:output_type -> temporalio.cafe.CustomerLoyaltyPointsRedeemResult
+	41, // 126: temporalio.cafe.Cafe.CustomerLoyaltyPointsReleaseUpdate:output_type -> temporalio.cafe.CustomerLoyaltyPointsBalance
+	41, // 127: temporalio.cafe.Cafe.CustomerLoyaltyPointsAdjustUpdate:output_type -> temporalio.cafe.CustomerLoyaltyPointsBalance
+	54, // 128: temporalio.cafe.Cafe.CustomerStatusQuery:output_type -> temporalio.cafe.CustomerStatus
+	53, // 129: temporalio.cafe.Cafe.CustomerLedgerQuery:output_type -> temporalio.cafe.CustomerLedger
+	56, // 130: temporalio.cafe.Cafe.CustomerForgetUpdate:output_type -> temporalio.cafe.CustomerForgetResult
+	59, // 131: temporalio.cafe.Cafe.CustomerErasure:output_type -> temporalio.cafe.CustomerErasureResult
+	98, // 132: temporalio.cafe.Cafe.GiftCard:output_type -> google.protobuf.Empty
+	90, // 133: temporalio.cafe.Cafe.GiftCardStatusQuery:output_type -> temporalio.cafe.GiftCardStatus
+	90, // 134: temporalio.cafe.Cafe.GiftCardTopUpUpdate:output_type -> temporalio.cafe.GiftCardStatus
+	93, // 135: temporalio.cafe.Cafe.GiftCardRedeemUpdate:output_type -> temporalio.cafe.GiftCardRedeemResult
+	90, // 136: temporalio.cafe.Cafe.GiftCardRefundUpdate:output_type -> temporalio.cafe.GiftCardStatus
+	99, // [99:137] is the sub-list for method output_type
+	61, // [61:99] is the sub-list for method input_type
+	61, // [61:61] is the sub-list for extension type_name
+	61, // [61:61] is the sub-list for extension extendee
+	0,  // [0:61] is the sub-list for field type_name
+}
+
+func init() { file_cafe_proto_init() }
+func file_cafe_proto_init() {
+	if File_cafe_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_cafe_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Menu); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MenuItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MenuModifierGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MenuModifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MenuItemRemoveInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MenuItemAvailabilityInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderLineItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderLineItemModifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tender); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderTenderStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderCancelInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderModifyInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderSequenceNextInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderSequenceNextResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StationRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StationRouting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StationOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StationOrderLineItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StationOrderInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StationOrderItemStatusInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StationOrderPriorityInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StationOrderItemsUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StationOrderStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StationOrderResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StationQueueInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StationQueueOrder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StationQueuePosition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StationQueueStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueStationOrderInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueStationOrderResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindClosedStationOrdersInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindClosedStationOrdersResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomerLoyaltyPointsBalance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomerLoyaltyPointsEarned); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoyaltyProgram); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoyaltyMultiplier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoyaltyPromotion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoyaltyTier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomerLoyaltyPointsRedeemInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomerLoyaltyPointsRedeemResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomerLoyaltyPointsReleaseInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomerLoyaltyPointsAdjustInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomerLoyaltyTransaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomerLedgerInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomerLedger); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomerStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomerInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomerForgetResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomerErasureInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomerErasureAudit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomerErasureResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Payment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizePaymentInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use AddLoyaltyPointsResult.ProtoReflect.Descriptor instead.
-func (*AddLoyaltyPointsResult) Descriptor() ([]byte, []int) {
-	return file_cafe_proto_rawDescGZIP(), []int{24}
-}
-
-var File_cafe_proto protoreflect.FileDescriptor
-
-var file_cafe_proto_rawDesc = []byte{
-	0x0a, 0x0a, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x74, 0x65,
-	0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x1a, 0x1b, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x37, 0x0a, 0x04, 0x4d, 0x65,
-	0x6e, 0x75, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63,
-	0x61, 0x66, 0x65, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74,
-	0x65, 0x6d, 0x73, 0x22, 0x66, 0x0a, 0x08, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x12,
-	0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e,
-	0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e,
-	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x0d,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x30, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x74, 0x65,
-	0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0x91, 0x01, 0x0a, 0x0a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x34, 0x0a,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74,
-	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74,
-	0x65, 0x6d, 0x73, 0x22, 0x0d, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x22, 0x6b, 0x0a, 0x14, 0x4b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3f,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27,
-	0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65,
-	0x2e, 0x4b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65,
-	0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0x5d, 0x0a, 0x11, 0x4b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
-	0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72,
-	0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c,
-	0x69, 0x6e, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x73,
-	0x0a, 0x1c, 0x4b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74,
-	0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6c, 0x69,
-	0x6e, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x27, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e,
-	0x63, 0x61, 0x66, 0x65, 0x2e, 0x4b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x22, 0x79, 0x0a, 0x12, 0x4b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6f, 0x70, 0x65,
-	0x6e, 0x12, 0x3b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x25, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61,
-	0x66, 0x65, 0x2e, 0x4b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c,
-	0x69, 0x6e, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x14,
-	0x0a, 0x12, 0x4b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x22, 0x6b, 0x0a, 0x14, 0x42, 0x61, 0x72, 0x69, 0x73, 0x74, 0x61, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x3f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x27, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61,
-	0x66, 0x65, 0x2e, 0x42, 0x61, 0x72, 0x69, 0x73, 0x74, 0x61, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
-	0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0x5d, 0x0a, 0x11, 0x42, 0x61, 0x72, 0x69, 0x73, 0x74, 0x61, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x69, 0x74,
-	0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x65, 0x6d, 0x70,
-	0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x22, 0x73, 0x0a, 0x1c, 0x42, 0x61, 0x72, 0x69, 0x73, 0x74, 0x61, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
-	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69,
-	0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x42, 0x61, 0x72, 0x69, 0x73, 0x74, 0x61, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x79, 0x0a, 0x12, 0x42, 0x61, 0x72, 0x69, 0x73, 0x74, 0x61,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6f,
-	0x70, 0x65, 0x6e, 0x12, 0x3b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e,
-	0x63, 0x61, 0x66, 0x65, 0x2e, 0x42, 0x61, 0x72, 0x69, 0x73, 0x74, 0x61, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x22, 0x14, 0x0a, 0x12, 0x42, 0x61, 0x72, 0x69, 0x73, 0x74, 0x61, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x36, 0x0a, 0x1c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x65, 0x72, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x42,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x35,
-	0x0a, 0x1b, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74,
-	0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x45, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x25, 0x0a, 0x0d, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
-	0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x25, 0x0a, 0x07,
-	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x63,
-	0x6f, 0x64, 0x65, 0x22, 0x2b, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x50, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x22, 0x4a, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x6d, 0x70,
-	0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x50, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x4f, 0x0a, 0x19,
-	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x66, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x6d,
-	0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x50, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x1c, 0x0a,
-	0x1a, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x45, 0x0a, 0x15, 0x41,
-	0x64, 0x64, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x49,
-	0x6e, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79,
-	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2a, 0x59, 0x0a, 0x0b,
-	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x50,
-	0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e,
-	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x4f, 0x4f, 0x44, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15,
-	0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x45, 0x56,
-	0x45, 0x52, 0x41, 0x47, 0x45, 0x10, 0x02, 0x2a, 0xb5, 0x01, 0x0a, 0x16, 0x4b, 0x69, 0x74, 0x63,
-	0x68, 0x65, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x25, 0x0a, 0x21, 0x4b, 0x49, 0x54, 0x43, 0x48, 0x45, 0x4e, 0x5f, 0x4f, 0x52,
-	0x44, 0x45, 0x52, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x4b, 0x49, 0x54,
-	0x43, 0x48, 0x45, 0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x01,
-	0x12, 0x27, 0x0a, 0x23, 0x4b, 0x49, 0x54, 0x43, 0x48, 0x45, 0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45,
-	0x52, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f,
-	0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x4b, 0x49, 0x54,
-	0x43, 0x48, 0x45, 0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a,
-	0xb5, 0x01, 0x0a, 0x16, 0x42, 0x61, 0x72, 0x69, 0x73, 0x74, 0x61, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x21, 0x42, 0x41,
-	0x52, 0x49, 0x53, 0x54, 0x41, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x49, 0x54, 0x45, 0x4d,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
-	0x00, 0x12, 0x25, 0x0a, 0x21, 0x42, 0x41, 0x52, 0x49, 0x53, 0x54, 0x41, 0x5f, 0x4f, 0x52, 0x44,
-	0x45, 0x52, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53,
-	0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x27, 0x0a, 0x23, 0x42, 0x41, 0x52, 0x49,
-	0x53, 0x54, 0x41, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10,
-	0x02, 0x12, 0x24, 0x0a, 0x20, 0x42, 0x41, 0x52, 0x49, 0x53, 0x54, 0x41, 0x5f, 0x4f, 0x52, 0x44,
-	0x45, 0x52, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46,
-	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x32, 0xcd, 0x07, 0x0a, 0x04, 0x43, 0x61, 0x66, 0x65,
-	0x12, 0x44, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x74, 0x65, 0x6d, 0x70,
-	0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
-	0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x1c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46,
-	0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0c, 0x4b, 0x69, 0x74, 0x63,
-	0x68, 0x65, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f,
-	0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4b, 0x69, 0x74, 0x63, 0x68,
-	0x65, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x23, 0x2e, 0x74,
-	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4b,
-	0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x17, 0x4b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x23, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
-	0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x4b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x67, 0x0a,
-	0x1c, 0x4b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65,
-	0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x2d, 0x2e,
-	0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e,
-	0x4b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0c, 0x42, 0x61, 0x72, 0x69, 0x73, 0x74,
-	0x61, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
-	0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x42, 0x61, 0x72, 0x69, 0x73, 0x74, 0x61,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x65, 0x6d,
-	0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x42, 0x61, 0x72,
-	0x69, 0x73, 0x74, 0x61, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
-	0x00, 0x12, 0x58, 0x0a, 0x17, 0x42, 0x61, 0x72, 0x69, 0x73, 0x74, 0x61, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x23, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69,
-	0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x42, 0x61, 0x72, 0x69, 0x73, 0x74, 0x61, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x1c, 0x42,
-	0x61, 0x72, 0x69, 0x73, 0x74, 0x61, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x2d, 0x2e, 0x74, 0x65,
-	0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x42, 0x61,
-	0x72, 0x69, 0x73, 0x74, 0x61, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x21, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
-	0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x45, 0x61, 0x72,
-	0x6e, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x2c, 0x2e, 0x74, 0x65, 0x6d, 0x70,
-	0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x43, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x45, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x83, 0x01, 0x0a, 0x21, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x6f,
-	0x79, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x2d, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72,
-	0x61, 0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x65, 0x72, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x42,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x2d, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
-	0x6c, 0x69, 0x6f, 0x2e, 0x63, 0x61, 0x66, 0x65, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
-	0x72, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x42, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x00, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f,
-	0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2d, 0x63, 0x61, 0x66, 0x65, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_cafe_proto_rawDescOnce sync.Once
-	file_cafe_proto_rawDescData = file_cafe_proto_rawDesc
-)
-
-func file_cafe_proto_rawDescGZIP() []byte {
-	file_cafe_proto_rawDescOnce.Do(func() {
-		file_cafe_proto_rawDescData = protoimpl.X.CompressGZIP(file_cafe_proto_rawDescData)
-	})
-	return file_cafe_proto_rawDescData
-}
-
-var file_cafe_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_cafe_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
-var file_cafe_proto_goTypes = []interface{}{
-	(ProductType)(0),                     // 0: temporalio.cafe.ProductType
-	(KitchenOrderItemStatus)(0),          // 1: temporalio.cafe.KitchenOrderItemStatus
-	(BaristaOrderItemStatus)(0),          // 2: temporalio.cafe.BaristaOrderItemStatus
-	(*Menu)(nil),                         // 3: temporalio.cafe.Menu
-	(*MenuItem)(nil),                     // 4: temporalio.cafe.MenuItem
-	(*OrderLineItem)(nil),                // 5: temporalio.cafe.OrderLineItem
-	(*OrderInput)(nil),                   // 6: temporalio.cafe.OrderInput
-	(*OrderResult)(nil),                  // 7: temporalio.cafe.OrderResult
-	(*KitchenOrderLineItem)(nil),         // 8: temporalio.cafe.KitchenOrderLineItem
-	(*KitchenOrderInput)(nil),            // 9: temporalio.cafe.KitchenOrderInput
-	(*KitchenOrderItemStatusUpdate)(nil), // 10: temporalio.cafe.KitchenOrderItemStatusUpdate
-	(*KitchenOrderStatus)(nil),           // 11: temporalio.cafe.KitchenOrderStatus
-	(*KitchenOrderResult)(nil),           // 12: temporalio.cafe.KitchenOrderResult
-	(*BaristaOrderLineItem)(nil),         // 13: temporalio.cafe.BaristaOrderLineItem
-	(*BaristaOrderInput)(nil),            // 14: temporalio.cafe.BaristaOrderInput
-	(*BaristaOrderItemStatusUpdate)(nil), // 15: temporalio.cafe.BaristaOrderItemStatusUpdate
-	(*BaristaOrderStatus)(nil),           // 16: temporalio.cafe.BaristaOrderStatus
-	(*BaristaOrderResult)(nil),           // 17: temporalio.cafe.BaristaOrderResult
-	(*CustomerLoyaltyPointsBalance)(nil), // 18: temporalio.cafe.CustomerLoyaltyPointsBalance
-	(*CustomerLoyaltyPointsEarned)(nil),  // 19: temporalio.cafe.CustomerLoyaltyPointsEarned
-	(*CustomerInput)(nil),                // 20: temporalio.cafe.CustomerInput
-	(*Payment)(nil),                      // 21: temporalio.cafe.Payment
-	(*ProcessPaymentInput)(nil),          // 22: temporalio.cafe.ProcessPaymentInput
-	(*ProcessPaymentResult)(nil),         // 23: temporalio.cafe.ProcessPaymentResult
-	(*ProcessPaymentRefundInput)(nil),    // 24: temporalio.cafe.ProcessPaymentRefundInput
-	(*ProcessPaymentRefundResult)(nil),   // 25: temporalio.cafe.ProcessPaymentRefundResult
-	(*AddLoyaltyPointsInput)(nil),        // 26: temporalio.cafe.AddLoyaltyPointsInput
-	(*AddLoyaltyPointsResult)(nil),       // 27: temporalio.cafe.AddLoyaltyPointsResult
-	(*emptypb.Empty)(nil),                // 28: google.protobuf.Empty
-}
-var file_cafe_proto_depIdxs = []int32{
-	4,  // 0: temporalio.cafe.Menu.items:type_name -> temporalio.cafe.MenuItem
-	0,  // 1: temporalio.cafe.MenuItem.type:type_name -> temporalio.cafe.ProductType
-	0,  // 2: temporalio.cafe.OrderLineItem.type:type_name -> temporalio.cafe.ProductType
-	5,  // 3: temporalio.cafe.OrderInput.items:type_name -> temporalio.cafe.OrderLineItem
-	1,  // 4: temporalio.cafe.KitchenOrderLineItem.status:type_name -> temporalio.cafe.KitchenOrderItemStatus
-	5,  // 5: temporalio.cafe.KitchenOrderInput.items:type_name -> temporalio.cafe.OrderLineItem
-	1,  // 6: temporalio.cafe.KitchenOrderItemStatusUpdate.status:type_name -> temporalio.cafe.KitchenOrderItemStatus
-	8,  // 7: temporalio.cafe.KitchenOrderStatus.items:type_name -> temporalio.cafe.KitchenOrderLineItem
-	2,  // 8: temporalio.cafe.BaristaOrderLineItem.status:type_name -> temporalio.cafe.BaristaOrderItemStatus
-	5,  // 9: temporalio.cafe.BaristaOrderInput.items:type_name -> temporalio.cafe.OrderLineItem
-	2,  // 10: temporalio.cafe.BaristaOrderItemStatusUpdate.status:type_name -> temporalio.cafe.BaristaOrderItemStatus
-	13, // 11: temporalio.cafe.BaristaOrderStatus.items:type_name -> temporalio.cafe.BaristaOrderLineItem
-	21, // 12: temporalio.cafe.ProcessPaymentResult.payment:type_name -> temporalio.cafe.Payment
-	21, // 13: temporalio.cafe.ProcessPaymentRefundInput.payment:type_name -> temporalio.cafe.Payment
-	6,  // 14: temporalio.cafe.Cafe.Order:input_type -> temporalio.cafe.OrderInput
-	28, // 15: temporalio.cafe.Cafe.OrderFulfilmentStartedSignal:input_type -> google.protobuf.Empty
-	9,  // 16: temporalio.cafe.Cafe.KitchenOrder:input_type -> temporalio.cafe.KitchenOrderInput
-	28, // 17: temporalio.cafe.Cafe.KitchenOrderStatusQuery:input_type -> google.protobuf.Empty
-	10, // 18: temporalio.cafe.Cafe.KitchenOrderItemStatusSignal:input_type -> temporalio.cafe.KitchenOrderItemStatusUpdate
-	14, // 19: temporalio.cafe.Cafe.BaristaOrder:input_type -> temporalio.cafe.BaristaOrderInput
-	28, // 20: temporalio.cafe.Cafe.BaristaOrderStatusQuery:input_type -> google.protobuf.Empty
-	15, // 21: temporalio.cafe.Cafe.BaristaOrderItemStatusSignal:input_type -> temporalio.cafe.BaristaOrderItemStatusUpdate
-	19, // 22: temporalio.cafe.Cafe.CustomerLoyaltyPointsEarnedSignal:input_type -> temporalio.cafe.CustomerLoyaltyPointsEarned
-	18, // 23: temporalio.cafe.Cafe.CustomerLoyaltyPointsBalanceQuery:input_type -> temporalio.cafe.CustomerLoyaltyPointsBalance
-	7,  // 24: temporalio.cafe.Cafe.Order:output_type -> temporalio.cafe.OrderResult
-	28, // 25: temporalio.cafe.Cafe.OrderFulfilmentStartedSignal:output_type -> google.protobuf.Empty
-	12, // 26: temporalio.cafe.Cafe.KitchenOrder:output_type -> temporalio.cafe.KitchenOrderResult
-	11, // 27: temporalio.cafe.Cafe.KitchenOrderStatusQuery:output_type -> temporalio.cafe.KitchenOrderStatus
-	28, // 28: temporalio.cafe.Cafe.KitchenOrderItemStatusSignal:output_type -> google.protobuf.Empty
-	17, // 29: temporalio.cafe.Cafe.BaristaOrder:output_type -> temporalio.cafe.BaristaOrderResult
-	16, // 30: temporalio.cafe.Cafe.BaristaOrderStatusQuery:output_type -> temporalio.cafe.BaristaOrderStatus
-	28, // 31: temporalio.cafe.Cafe.BaristaOrderItemStatusSignal:output_type -> google.protobuf.Empty
-	28, // 32: temporalio.cafe.Cafe.CustomerLoyaltyPointsEarnedSignal:output_type -> google.protobuf.Empty
-	18, // 33: temporalio.cafe.Cafe.CustomerLoyaltyPointsBalanceQuery:output_type -> temporalio.cafe.CustomerLoyaltyPointsBalance
-	24, // [24:34] is the sub-list for method output_type
-	14, // [14:24] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
-}
-
-func init() { file_cafe_proto_init() }
-func file_cafe_proto_init() {
-	if File_cafe_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_cafe_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Menu); i {
+		file_cafe_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizePaymentResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1782,8 +7671,8 @@ func file_cafe_proto_init() {
 				return nil
 			}
 		}
-		file_cafe_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MenuItem); i {
+		file_cafe_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CapturePaymentInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1794,8 +7683,8 @@ func file_cafe_proto_init() {
 				return nil
 			}
 		}
-		file_cafe_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderLineItem); i {
+		file_cafe_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CapturePaymentResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1806,8 +7695,8 @@ func file_cafe_proto_init() {
 				return nil
 			}
 		}
-		file_cafe_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderInput); i {
+		file_cafe_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoidPaymentInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1818,8 +7707,8 @@ func file_cafe_proto_init() {
 				return nil
 			}
 		}
-		file_cafe_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderResult); i {
+		file_cafe_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoidPaymentResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1830,8 +7719,8 @@ func file_cafe_proto_init() {
 				return nil
 			}
 		}
-		file_cafe_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KitchenOrderLineItem); i {
+		file_cafe_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessPaymentRefundInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1842,8 +7731,8 @@ func file_cafe_proto_init() {
 				return nil
 			}
 		}
-		file_cafe_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KitchenOrderInput); i {
+		file_cafe_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessPaymentRefundResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1854,8 +7743,8 @@ func file_cafe_proto_init() {
 				return nil
 			}
 		}
-		file_cafe_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KitchenOrderItemStatusUpdate); i {
+		file_cafe_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedeemGiftCardInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1866,8 +7755,8 @@ func file_cafe_proto_init() {
 				return nil
 			}
 		}
-		file_cafe_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KitchenOrderStatus); i {
+		file_cafe_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedeemGiftCardResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1878,8 +7767,8 @@ func file_cafe_proto_init() {
 				return nil
 			}
 		}
-		file_cafe_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KitchenOrderResult); i {
+		file_cafe_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefundGiftCardInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1890,8 +7779,8 @@ func file_cafe_proto_init() {
 				return nil
 			}
 		}
-		file_cafe_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BaristaOrderLineItem); i {
+		file_cafe_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefundGiftCardResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1902,8 +7791,8 @@ func file_cafe_proto_init() {
 				return nil
 			}
 		}
-		file_cafe_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BaristaOrderInput); i {
+		file_cafe_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedeemLoyaltyPointsInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1914,8 +7803,8 @@ func file_cafe_proto_init() {
 				return nil
 			}
 		}
-		file_cafe_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BaristaOrderItemStatusUpdate); i {
+		file_cafe_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedeemLoyaltyPointsResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1926,8 +7815,8 @@ func file_cafe_proto_init() {
 				return nil
 			}
 		}
-		file_cafe_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BaristaOrderStatus); i {
+		file_cafe_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseLoyaltyPointsInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1938,8 +7827,8 @@ func file_cafe_proto_init() {
 				return nil
 			}
 		}
-		file_cafe_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BaristaOrderResult); i {
+		file_cafe_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseLoyaltyPointsResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1950,8 +7839,8 @@ func file_cafe_proto_init() {
 				return nil
 			}
 		}
-		file_cafe_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CustomerLoyaltyPointsBalance); i {
+		file_cafe_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddLoyaltyPointsInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1962,8 +7851,8 @@ func file_cafe_proto_init() {
 				return nil
 			}
 		}
-		file_cafe_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CustomerLoyaltyPointsEarned); i {
+		file_cafe_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveCustomerLedgerInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1974,8 +7863,8 @@ func file_cafe_proto_init() {
 				return nil
 			}
 		}
-		file_cafe_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CustomerInput); i {
+		file_cafe_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveCustomerLedgerResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1986,8 +7875,8 @@ func file_cafe_proto_init() {
 				return nil
 			}
 		}
-		file_cafe_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Payment); i {
+		file_cafe_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForgetCustomerInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1998,8 +7887,8 @@ func file_cafe_proto_init() {
 				return nil
 			}
 		}
-		file_cafe_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessPaymentInput); i {
+		file_cafe_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForgetCustomerResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2010,8 +7899,8 @@ func file_cafe_proto_init() {
 				return nil
 			}
 		}
-		file_cafe_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessPaymentResult); i {
+		file_cafe_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForgetOrderInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2022,8 +7911,8 @@ func file_cafe_proto_init() {
 				return nil
 			}
 		}
-		file_cafe_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessPaymentRefundInput); i {
+		file_cafe_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForgetOrderResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2034,8 +7923,8 @@ func file_cafe_proto_init() {
 				return nil
 			}
 		}
-		file_cafe_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessPaymentRefundResult); i {
+		file_cafe_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCustomerRecordsInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2046,8 +7935,8 @@ func file_cafe_proto_init() {
 				return nil
 			}
 		}
-		file_cafe_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddLoyaltyPointsInput); i {
+		file_cafe_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCustomerRecordsResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2058,7 +7947,31 @@ func file_cafe_proto_init() {
 				return nil
 			}
 		}
-		file_cafe_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_cafe_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordCustomerErasureInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordCustomerErasureResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddLoyaltyPointsResult); i {
 			case 0:
 				return &v.state
@@ -2070,14 +7983,86 @@ func file_cafe_proto_init() {
 				return nil
 			}
 		}
+		file_cafe_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GiftCardInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GiftCardStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GiftCardTopUpInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GiftCardRedeemInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GiftCardRedeemResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cafe_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GiftCardRefundInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cafe_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   25,
+			NumEnums:      6,
+			NumMessages:   90,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1,58 +1,695 @@
 package workflows
 
 import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/temporalio/temporal-cafe/activities"
 	"github.com/temporalio/temporal-cafe/proto"
+	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CustomerLoyaltyPointValue is the amount a loyalty point is worth when paying for an order
+const CustomerLoyaltyPointValue = 10
+
+// CustomerReservationLimit is the number of point reservations remembered by a customer
+const CustomerReservationLimit = 100
+
+// CustomerLedgerLimit is the number of ledger entries kept by a customer before the oldest are archived
+const CustomerLedgerLimit = 100
+
+// CustomerCreditLimit is the number of credited orders remembered by a customer, so that retried
+// credits are only applied once
+const CustomerCreditLimit = 1000
+
+// CustomerRecentTransactions is the number of transactions included in a customer's status
+const CustomerRecentTransactions = 10
+
+// Error types used when rejecting customer updates
+const (
+	CustomerInvalidError             = "CustomerInvalid"
+	CustomerNoPointsError            = "CustomerNoPoints"
+	CustomerReservationNotFoundError = "CustomerReservationNotFound"
+	CustomerForgottenError           = activities.CustomerForgottenError
 )
 
-// CustomerStartingBalance is the number of points to credit a loyalty account on signup
-const CustomerStartingBalance = 100
+// CustomerID returns the workflow ID for a customer's email address
+func CustomerID(email string) string {
+	return fmt.Sprintf("customer:%s", email)
+}
+
+// CustomerTransaction is an entry in the customer's points ledger.
+type CustomerTransaction struct {
+	Seq       uint64
+	Type      proto.CustomerLoyaltyTransactionType
+	Points    int32
+	Balance   uint32
+	OrderID   string
+	Reference string
+	Reason    string
+	Time      time.Time
+}
+
+func (t *CustomerTransaction) proto() *proto.CustomerLoyaltyTransaction {
+	return &proto.CustomerLoyaltyTransaction{
+		Seq:       t.Seq,
+		Type:      t.Type,
+		Points:    t.Points,
+		Balance:   t.Balance,
+		OrderId:   t.OrderID,
+		Reference: t.Reference,
+		Reason:    t.Reason,
+		Time:      timestamppb.New(t.Time),
+	}
+}
+
+// CustomerPointsLot is a number of points which expire together. Lots without an expiry never expire.
+type CustomerPointsLot struct {
+	Points  uint32
+	Expires time.Time
+}
+
+// CustomerSpend is an amount spent by the customer, which counts towards their tier until it leaves
+// the tier window.
+type CustomerSpend struct {
+	Amount uint32
+	Time   time.Time
+}
+
+type CustomerPointsReservation struct {
+	Reference string
+	OrderID   string
+	Points    uint32
+	Released  uint32
+	// Lots the points were taken from, so that released points keep their expiry.
+	Lots []CustomerPointsLot
+}
 
 type CustomerWorkflowState struct {
-	Points uint32
+	Points       uint32
+	Lots         []CustomerPointsLot
+	Spend        []CustomerSpend
+	Tier         string
+	Program      *proto.LoyaltyProgram
+	Reservations []CustomerPointsReservation
+	// Transactions is the ledger of changes to the customer's points, oldest first. Entries before
+	// Archived have been moved to the ledger archive.
+	Transactions []CustomerTransaction
+	LastSeq      uint64
+	Archived     uint64
+	// CreditedOrders are the most recent orders points have been earned on.
+	CreditedOrders []string
+
+	// forgotten is set once the customer has asked to be forgotten, after which the workflow
+	// completes rather than continuing as new.
+	forgotten *proto.CustomerForgetResult
 }
 
-// NewCustomerWorkflowState creates a workflow state
-func NewCustomerWorkflowState(state *CustomerWorkflowState) *CustomerWorkflowState {
-	if state != nil {
-		return state
+// NewCustomerWorkflowState creates a workflow state, crediting new customers with the starting
+// balance of their loyalty program.
+func NewCustomerWorkflowState(ctx workflow.Context, input *proto.CustomerInput, state *CustomerWorkflowState) *CustomerWorkflowState {
+	if state == nil {
+		state = &CustomerWorkflowState{Program: input.Program}
+		if state.Program == nil {
+			state.Program = activities.DefaultLoyaltyProgram()
+		}
+		state.credit(ctx, state.Program.StartingBalance)
+		state.record(ctx, CustomerTransaction{
+			Type:   proto.CustomerLoyaltyTransactionType_CUSTOMER_LOYALTY_TRANSACTION_TYPE_STARTING_BALANCE,
+			Points: int32(state.Program.StartingBalance),
+		})
+	}
+	if state.Program == nil {
+		state.Program = activities.DefaultLoyaltyProgram()
+	}
+
+	// Points from before points expired are kept without an expiry.
+	var lots uint32
+	for _, l := range state.Lots {
+		lots += l.Points
+	}
+	if state.Points > lots {
+		state.addLot(CustomerPointsLot{Points: state.Points - lots})
 	}
 
-	return &CustomerWorkflowState{Points: CustomerStartingBalance}
+	return state
 }
 
-func handleEvents(ctx workflow.Context, state *CustomerWorkflowState) error {
-	ch := workflow.GetSignalChannel(ctx, proto.CustomerLoyaltyPointsEarnedSignal)
-	s := workflow.NewSelector(ctx)
+// credit adds points which expire after the program's expiry period.
+func (s *CustomerWorkflowState) credit(ctx workflow.Context, points uint32) {
+	if points == 0 {
+		return
+	}
 
-	s.AddReceive(ch, func(c workflow.ReceiveChannel, _ bool) {
-		var signal proto.CustomerLoyaltyPointsEarned
-		c.Receive(ctx, &signal)
+	lot := CustomerPointsLot{Points: points}
+	if s.Program.PointsExpiryDays > 0 {
+		lot.Expires = workflow.Now(ctx).Add(time.Duration(s.Program.PointsExpiryDays) * 24 * time.Hour)
+	}
+	s.addLot(lot)
+	s.Points += points
+}
+
+// record appends a transaction to the customer's ledger, after the balance has been changed.
+func (s *CustomerWorkflowState) record(ctx workflow.Context, t CustomerTransaction) {
+	s.LastSeq++
+	t.Seq = s.LastSeq
+	t.Balance = s.Points
+	t.Time = workflow.Now(ctx)
+
+	s.Transactions = append(s.Transactions, t)
+}
+
+// archive moves the oldest ledger entries to the archive once the ledger is over its limit, keeping
+// half the limit in the workflow. Entries are only removed once they have been archived.
+func (s *CustomerWorkflowState) archive(ctx workflow.Context, email string) error {
+	if len(s.Transactions) <= CustomerLedgerLimit {
+		return nil
+	}
+
+	n := len(s.Transactions) - CustomerLedgerLimit/2
+	var entries []*proto.CustomerLoyaltyTransaction
+	for i := 0; i < n; i++ {
+		entries = append(entries, s.Transactions[i].proto())
+	}
+
+	if err := archiveCustomerLedger(ctx, email, entries); err != nil {
+		return err
+	}
+
+	s.Archived = s.Transactions[n-1].Seq + 1
+	s.Transactions = s.Transactions[n:]
 
-		state.Points += signal.Points
+	return nil
+}
+
+func archiveCustomerLedger(ctx workflow.Context, email string, entries []*proto.CustomerLoyaltyTransaction) error {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 3,
+		},
 	})
 
-	for {
-		s.Select(ctx)
-		if workflow.GetInfo(ctx).GetContinueAsNewSuggested() {
+	return workflow.ExecuteActivity(
+		ctx,
+		a.ArchiveCustomerLedger,
+		&proto.ArchiveCustomerLedgerInput{Email: email, Entries: entries},
+	).Get(ctx, nil)
+}
+
+// addLot adds a lot to the balance, keeping lots in the order they expire.
+func (s *CustomerWorkflowState) addLot(lot CustomerPointsLot) {
+	for i := range s.Lots {
+		if s.Lots[i].Expires.Equal(lot.Expires) {
+			s.Lots[i].Points += lot.Points
+			return
+		}
+	}
+
+	s.Lots = append(s.Lots, lot)
+	sort.SliceStable(s.Lots, func(i, j int) bool {
+		a, b := s.Lots[i].Expires, s.Lots[j].Expires
+		if a.IsZero() || b.IsZero() {
+			return b.IsZero() && !a.IsZero()
+		}
+		return a.Before(b)
+	})
+}
+
+// take removes points from the lots which expire soonest, returning the points taken from each lot.
+func (s *CustomerWorkflowState) take(points uint32) []CustomerPointsLot {
+	var taken []CustomerPointsLot
+	for points > 0 && len(s.Lots) > 0 {
+		l := &s.Lots[0]
+		p := l.Points
+		if p > points {
+			p = points
+		}
+		taken = append(taken, CustomerPointsLot{Points: p, Expires: l.Expires})
+		l.Points -= p
+		s.Points -= p
+		points -= p
+		if l.Points == 0 {
+			s.Lots = s.Lots[1:]
+		}
+	}
+
+	return taken
+}
+
+// credited reports whether points have already been earned on an order.
+func (s *CustomerWorkflowState) credited(orderID string) bool {
+	for _, id := range s.CreditedOrders {
+		if id == orderID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// earn credits points earned by spending, adding the bonus for the customer's tier. Each order is
+// only credited once, however many times its points are sent.
+func (s *CustomerWorkflowState) earn(ctx workflow.Context, signal *proto.CustomerLoyaltyPointsEarned) {
+	if signal.OrderId != "" {
+		if s.credited(signal.OrderId) {
+			workflow.GetLogger(ctx).Info("order already credited", "order", signal.OrderId)
+			return
+		}
+		s.CreditedOrders = append(s.CreditedOrders, signal.OrderId)
+		if len(s.CreditedOrders) > CustomerCreditLimit {
+			s.CreditedOrders = s.CreditedOrders[len(s.CreditedOrders)-CustomerCreditLimit:]
+		}
+	}
+
+	if signal.Program != nil {
+		s.Program = signal.Program
+	}
+
+	if signal.Spend > 0 {
+		s.Spend = append(s.Spend, CustomerSpend{Amount: signal.Spend, Time: workflow.Now(ctx)})
+		s.updateTier(ctx)
+	}
+
+	points := signal.Points
+	var reason string
+	for _, t := range s.Program.Tiers {
+		if t.Name == s.Tier && t.Percent > 0 {
+			points += signal.Points * t.Percent / 100
+			reason = fmt.Sprintf("%s tier bonus", t.Name)
+		}
+	}
+	if points == 0 {
+		return
+	}
+	s.credit(ctx, points)
+	s.record(ctx, CustomerTransaction{
+		Type:    proto.CustomerLoyaltyTransactionType_CUSTOMER_LOYALTY_TRANSACTION_TYPE_EARNED,
+		Points:  int32(points),
+		OrderID: signal.OrderId,
+		Reason:  reason,
+	})
+}
+
+// updateTier drops spend which has left the tier window and sets the highest tier the customer's
+// remaining spend reaches.
+func (s *CustomerWorkflowState) updateTier(ctx workflow.Context) {
+	window := time.Duration(s.Program.TierWindowDays) * 24 * time.Hour
+	now := workflow.Now(ctx)
+	for len(s.Spend) > 0 && !s.Spend[0].Time.Add(window).After(now) {
+		s.Spend = s.Spend[1:]
+	}
+
+	spend := s.spend()
+	s.Tier = ""
+	var reached uint32
+	for _, t := range s.Program.Tiers {
+		if spend >= t.Spend && t.Spend >= reached {
+			s.Tier = t.Name
+			reached = t.Spend
+		}
+	}
+}
+
+func (s *CustomerWorkflowState) spend() uint32 {
+	var total uint32
+	for _, sp := range s.Spend {
+		total += sp.Amount
+	}
+
+	return total
+}
+
+// expire removes lots which have expired.
+func (s *CustomerWorkflowState) expire(ctx workflow.Context) {
+	now := workflow.Now(ctx)
+	for len(s.Lots) > 0 && !s.Lots[0].Expires.IsZero() && !s.Lots[0].Expires.After(now) {
+		points := s.Lots[0].Points
+		s.Points -= points
+		s.Lots = s.Lots[1:]
+		s.record(ctx, CustomerTransaction{
+			Type:   proto.CustomerLoyaltyTransactionType_CUSTOMER_LOYALTY_TRANSACTION_TYPE_EXPIRED,
+			Points: -int32(points),
+		})
+	}
+}
+
+// nextChange is when points next expire or spend next leaves the tier window, zero if neither will.
+func (s *CustomerWorkflowState) nextChange() time.Time {
+	var next time.Time
+	if len(s.Lots) > 0 {
+		next = s.Lots[0].Expires
+	}
+	if len(s.Spend) > 0 {
+		t := s.Spend[0].Time.Add(time.Duration(s.Program.TierWindowDays) * 24 * time.Hour)
+		if next.IsZero() || t.Before(next) {
+			next = t
+		}
+	}
+
+	return next
+}
+
+func (s *CustomerWorkflowState) balance() *proto.CustomerLoyaltyPointsBalance {
+	balance := &proto.CustomerLoyaltyPointsBalance{
+		Points: s.Points,
+		Tier:   s.Tier,
+		Spend:  s.spend(),
+	}
+	if len(s.Lots) > 0 && !s.Lots[0].Expires.IsZero() {
+		balance.ExpiringPoints = s.Lots[0].Points
+		balance.Expires = timestamppb.New(s.Lots[0].Expires)
+	}
+
+	return balance
+}
+
+// status returns the customer's balance and recent transactions, newest first.
+func (s *CustomerWorkflowState) status(email string) *proto.CustomerStatus {
+	status := &proto.CustomerStatus{Email: email, Loyalty: s.balance()}
+	status.Transactions = s.ledger(&proto.CustomerLedgerInput{Limit: CustomerRecentTransactions}).Entries
+
+	return status
+}
+
+// ledger returns a page of the ledger entries kept in the workflow, newest first.
+func (s *CustomerWorkflowState) ledger(input *proto.CustomerLedgerInput) *proto.CustomerLedger {
+	limit := int(input.Limit)
+	if limit == 0 || limit > CustomerLedgerLimit {
+		limit = CustomerLedgerLimit
+	}
+
+	ledger := &proto.CustomerLedger{Archived: s.Archived}
+	for i := len(s.Transactions) - 1; i >= 0; i-- {
+		t := s.Transactions[i]
+		if input.Before != 0 && t.Seq >= input.Before {
+			continue
+		}
+		if len(ledger.Entries) == limit {
+			ledger.Next = ledger.Entries[limit-1].Seq
 			break
 		}
+		ledger.Entries = append(ledger.Entries, t.proto())
+	}
+
+	return ledger
+}
+
+func (s *CustomerWorkflowState) find(reference string) int {
+	for i, r := range s.Reservations {
+		if r.Reference == reference {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (s *CustomerWorkflowState) validateRedeem(ctx workflow.Context, input *proto.CustomerLoyaltyPointsRedeemInput) error {
+	if s.forgotten != nil {
+		return errCustomerForgotten
+	}
+	if input.Reference == "" {
+		return temporal.NewApplicationError("reservation has no reference", CustomerInvalidError)
+	}
+	// Retries of a reservation we have already made are answered from the record of it.
+	if s.find(input.Reference) != -1 {
+		return nil
+	}
+	if s.Points == 0 {
+		return temporal.NewApplicationError("customer has no loyalty points", CustomerNoPointsError)
+	}
+
+	return nil
+}
+
+// handleRedeem reserves up to the requested number of points, taking them from the balance.
+func (s *CustomerWorkflowState) handleRedeem(ctx workflow.Context, input *proto.CustomerLoyaltyPointsRedeemInput) (*proto.CustomerLoyaltyPointsRedeemResult, error) {
+	if i := s.find(input.Reference); i != -1 {
+		return &proto.CustomerLoyaltyPointsRedeemResult{Points: s.Reservations[i].Points, Balance: s.Points}, nil
+	}
+
+	lots := s.take(input.Points)
+	var points uint32
+	for _, l := range lots {
+		points += l.Points
+	}
+
+	s.Reservations = append(s.Reservations, CustomerPointsReservation{Reference: input.Reference, OrderID: input.OrderId, Points: points, Lots: lots})
+	if len(s.Reservations) > CustomerReservationLimit {
+		s.Reservations = s.Reservations[len(s.Reservations)-CustomerReservationLimit:]
+	}
+	s.record(ctx, CustomerTransaction{
+		Type:      proto.CustomerLoyaltyTransactionType_CUSTOMER_LOYALTY_TRANSACTION_TYPE_REDEEMED,
+		Points:    -int32(points),
+		OrderID:   input.OrderId,
+		Reference: input.Reference,
+	})
+
+	return &proto.CustomerLoyaltyPointsRedeemResult{Points: points, Balance: s.Points}, nil
+}
+
+func (s *CustomerWorkflowState) validateRelease(ctx workflow.Context, input *proto.CustomerLoyaltyPointsReleaseInput) error {
+	if s.forgotten != nil {
+		return errCustomerForgotten
+	}
+	if s.find(input.Reference) == -1 {
+		return temporal.NewApplicationError(fmt.Sprintf("reservation not found: %s", input.Reference), CustomerReservationNotFoundError)
+	}
+
+	return nil
+}
+
+// handleRelease returns up to the requested number of reserved points to the balance. Points keep
+// the expiry they had when they were reserved, those returned last expiring first.
+func (s *CustomerWorkflowState) handleRelease(ctx workflow.Context, input *proto.CustomerLoyaltyPointsReleaseInput) (*proto.CustomerLoyaltyPointsBalance, error) {
+	r := &s.Reservations[s.find(input.Reference)]
+
+	points := input.Points
+	if points > r.Points-r.Released {
+		points = r.Points - r.Released
+	}
+	r.Released += points
+
+	remaining := points
+	for i := len(r.Lots) - 1; i >= 0 && remaining > 0; i-- {
+		l := &r.Lots[i]
+		p := l.Points
+		if p > remaining {
+			p = remaining
+		}
+		l.Points -= p
+		remaining -= p
+		s.addLot(CustomerPointsLot{Points: p, Expires: l.Expires})
+		s.Points += p
 	}
-	for s.HasPending() {
-		s.Select(ctx)
+	if points > 0 {
+		s.record(ctx, CustomerTransaction{
+			Type:      proto.CustomerLoyaltyTransactionType_CUSTOMER_LOYALTY_TRANSACTION_TYPE_RELEASED,
+			Points:    int32(points),
+			OrderID:   r.OrderID,
+			Reference: input.Reference,
+		})
+	}
+
+	return s.balance(), nil
+}
+
+// adjusted reports whether an adjustment with the reference has already been made.
+func (s *CustomerWorkflowState) adjusted(reference string) bool {
+	if reference == "" {
+		return false
+	}
+	for _, t := range s.Transactions {
+		if t.Type == proto.CustomerLoyaltyTransactionType_CUSTOMER_LOYALTY_TRANSACTION_TYPE_ADJUSTED && t.Reference == reference {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *CustomerWorkflowState) validateAdjust(ctx workflow.Context, input *proto.CustomerLoyaltyPointsAdjustInput) error {
+	if s.forgotten != nil {
+		return errCustomerForgotten
+	}
+	if input.Reason == "" {
+		return temporal.NewApplicationError("adjustment has no reason", CustomerInvalidError)
+	}
+	if input.Points == 0 {
+		return temporal.NewApplicationError("adjustment has no points", CustomerInvalidError)
+	}
+	if s.adjusted(input.Reference) {
+		return nil
+	}
+	if input.Points < 0 && uint32(-input.Points) > s.Points {
+		return temporal.NewApplicationError(fmt.Sprintf("customer only has %d points", s.Points), CustomerNoPointsError)
 	}
 
 	return nil
 }
 
+// handleAdjust corrects the customer's balance. Points added expire as if they were earned, points
+// removed are taken from those which expire soonest.
+func (s *CustomerWorkflowState) handleAdjust(ctx workflow.Context, input *proto.CustomerLoyaltyPointsAdjustInput) (*proto.CustomerLoyaltyPointsBalance, error) {
+	if s.adjusted(input.Reference) {
+		return s.balance(), nil
+	}
+
+	if input.Points > 0 {
+		s.credit(ctx, uint32(input.Points))
+	} else {
+		s.take(uint32(-input.Points))
+	}
+	s.record(ctx, CustomerTransaction{
+		Type:      proto.CustomerLoyaltyTransactionType_CUSTOMER_LOYALTY_TRANSACTION_TYPE_ADJUSTED,
+		Points:    input.Points,
+		Reference: input.Reference,
+		Reason:    input.Reason,
+	})
+
+	return s.balance(), nil
+}
+
+var errCustomerForgotten = temporal.NewApplicationError("customer has been forgotten", CustomerForgottenError)
+
+// orders returns the orders the customer's records refer to, oldest first.
+func (s *CustomerWorkflowState) orders() []string {
+	var orders []string
+	seen := make(map[string]bool)
+	add := func(id string) {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			orders = append(orders, id)
+		}
+	}
+
+	for _, id := range s.CreditedOrders {
+		add(id)
+	}
+	for _, r := range s.Reservations {
+		add(r.OrderID)
+	}
+	for _, t := range s.Transactions {
+		add(t.OrderID)
+	}
+
+	return orders
+}
+
+// handleForget erases the customer's loyalty account, returning the orders it referred to so that
+// they can be erased too. Points still reserved by orders are forfeited. Forgetting a customer
+// twice returns the same orders.
+func (s *CustomerWorkflowState) handleForget(ctx workflow.Context) (*proto.CustomerForgetResult, error) {
+	if s.forgotten != nil {
+		return s.forgotten, nil
+	}
+
+	forgotten := &proto.CustomerForgetResult{OrderIds: s.orders()}
+	*s = CustomerWorkflowState{Program: s.Program, forgotten: forgotten}
+
+	return forgotten, nil
+}
+
+// handleEvents credits points as they are earned and expires them when they are due. Expiry times
+// are kept in the state, so the timers are recreated after continue-as-new. The ledger is archived
+// as it grows.
+func (s *CustomerWorkflowState) handleEvents(ctx workflow.Context, email string) error {
+	ch := workflow.GetSignalChannel(ctx, proto.CustomerLoyaltyPointsEarnedSignal)
+
+	for {
+		s.expire(ctx)
+		s.updateTier(ctx)
+
+		// A failed archive is tried again once the ledger next changes.
+		archiveErr := s.archive(ctx, email)
+		if archiveErr != nil {
+			workflow.GetLogger(ctx).Error("failed to archive ledger", "error", archiveErr)
+		}
+		entries := len(s.Transactions)
+
+		next := s.nextChange()
+		changed := func() bool {
+			return ch.Len() > 0 || !s.nextChange().Equal(next) || workflow.GetInfo(ctx).GetContinueAsNewSuggested() || s.forgotten != nil ||
+				(archiveErr == nil && len(s.Transactions) > CustomerLedgerLimit) || (archiveErr != nil && len(s.Transactions) != entries)
+		}
+		if next.IsZero() {
+			if err := workflow.Await(ctx, changed); err != nil {
+				return err
+			}
+		} else {
+			if _, err := workflow.AwaitWithTimeout(ctx, next.Sub(workflow.Now(ctx)), changed); err != nil {
+				return err
+			}
+		}
+
+		var signal proto.CustomerLoyaltyPointsEarned
+		for ch.ReceiveAsync(&signal) {
+			// Points earned after the customer was forgotten are dropped rather than starting a
+			// new account.
+			if s.forgotten == nil {
+				s.earn(ctx, &signal)
+			}
+		}
+		if s.forgotten != nil {
+			return nil
+		}
+
+		if workflow.GetInfo(ctx).GetContinueAsNewSuggested() {
+			return nil
+		}
+	}
+}
+
 func Customer(ctx workflow.Context, input *proto.CustomerInput, state *CustomerWorkflowState) error {
-	wf := NewCustomerWorkflowState(state)
+	wf := NewCustomerWorkflowState(ctx, input, state)
 
 	workflow.SetQueryHandler(ctx, proto.CustomerLoyaltyPointsBalanceQuery, func() (*proto.CustomerLoyaltyPointsBalance, error) {
-		return &proto.CustomerLoyaltyPointsBalance{Points: wf.Points}, nil
+		return wf.balance(), nil
+	})
+	workflow.SetQueryHandler(ctx, proto.CustomerStatusQuery, func() (*proto.CustomerStatus, error) {
+		return wf.status(input.Email), nil
+	})
+	workflow.SetQueryHandler(ctx, proto.CustomerLedgerQuery, func(input *proto.CustomerLedgerInput) (*proto.CustomerLedger, error) {
+		return wf.ledger(input), nil
 	})
 
-	handleEvents(ctx, wf)
+	err := workflow.SetUpdateHandlerWithOptions(ctx, proto.CustomerLoyaltyPointsRedeemUpdate, wf.handleRedeem, workflow.UpdateHandlerOptions{
+		Validator: wf.validateRedeem,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = workflow.SetUpdateHandlerWithOptions(ctx, proto.CustomerLoyaltyPointsReleaseUpdate, wf.handleRelease, workflow.UpdateHandlerOptions{
+		Validator: wf.validateRelease,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = workflow.SetUpdateHandlerWithOptions(ctx, proto.CustomerLoyaltyPointsAdjustUpdate, wf.handleAdjust, workflow.UpdateHandlerOptions{
+		Validator: wf.validateAdjust,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = workflow.SetUpdateHandler(ctx, proto.CustomerForgetUpdate, wf.handleForget)
+	if err != nil {
+		return err
+	}
+
+	err = wf.handleEvents(ctx, input.Email)
+	if err != nil {
+		return err
+	}
+	if wf.forgotten != nil {
+		return nil
+	}
 
 	return workflow.NewContinueAsNewError(ctx, Customer, input, wf)
 }
@@ -1,11 +1,18 @@
 package workflows_test
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	act "github.com/temporalio/temporal-cafe/activities"
 	"github.com/temporalio/temporal-cafe/proto"
 	"github.com/temporalio/temporal-cafe/workflows"
+	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/testsuite"
 	"go.temporal.io/sdk/workflow"
 )
@@ -49,7 +56,7 @@ func TestCustomerWorkflow(t *testing.T) {
 	err = v.Get(&result)
 	assert.NoError(t, err)
 
-	assert.Equal(t, uint32(workflows.CustomerStartingBalance+5), result.Points)
+	assert.Equal(t, act.DefaultLoyaltyProgram().StartingBalance+5, result.Points)
 }
 
 func TestCustomerWorkflowContinue(t *testing.T) {
@@ -63,6 +70,8 @@ func TestCustomerWorkflowContinue(t *testing.T) {
 	}
 
 	env.RegisterDelayedCallback(func() {
+		env.SetContinueAsNewSuggested(true)
+
 		env.SignalWorkflow(
 			proto.CustomerLoyaltyPointsEarnedSignal,
 			proto.CustomerLoyaltyPointsEarned{Points: 3},
@@ -71,6 +80,8 @@ func TestCustomerWorkflowContinue(t *testing.T) {
 
 	env.ExecuteWorkflow(workflows.Customer, input, &workflows.CustomerWorkflowState{Points: 1})
 
+	assert.True(t, workflow.IsContinueAsNewError(env.GetWorkflowError()))
+
 	v, err := env.QueryWorkflow(proto.CustomerLoyaltyPointsBalanceQuery)
 	assert.NoError(t, err)
 	var result proto.CustomerLoyaltyPointsBalance
@@ -79,3 +90,426 @@ func TestCustomerWorkflowContinue(t *testing.T) {
 
 	assert.Equal(t, uint32(4), result.Points)
 }
+
+func TestCustomerWorkflowRedeem(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Customer)
+
+	var reserved []uint32
+	var rejected []string
+	redeem := &updateCallback{
+		reject: func(err error) {
+			var appErr *temporal.ApplicationError
+			if assert.True(t, errors.As(err, &appErr)) {
+				rejected = append(rejected, appErr.Type())
+			}
+		},
+		complete: func(result interface{}, err error) {
+			assert.NoError(t, err)
+			reserved = append(reserved, result.(*proto.CustomerLoyaltyPointsRedeemResult).Points)
+		},
+	}
+	release := &updateCallback{
+		reject: func(err error) {
+			var appErr *temporal.ApplicationError
+			if assert.True(t, errors.As(err, &appErr)) {
+				rejected = append(rejected, appErr.Type())
+			}
+		},
+		complete: func(result interface{}, err error) {
+			assert.NoError(t, err)
+		},
+	}
+
+	env.RegisterDelayedCallback(func() {
+		env.UpdateWorkflow(proto.CustomerLoyaltyPointsRedeemUpdate, "1", redeem, &proto.CustomerLoyaltyPointsRedeemInput{Reference: "order-1", Points: 30})
+		// A retry of the same redemption doesn't reserve any more points.
+		env.UpdateWorkflow(proto.CustomerLoyaltyPointsRedeemUpdate, "2", redeem, &proto.CustomerLoyaltyPointsRedeemInput{Reference: "order-1", Points: 30})
+		// Only the points left in the account can be reserved.
+		env.UpdateWorkflow(proto.CustomerLoyaltyPointsRedeemUpdate, "3", redeem, &proto.CustomerLoyaltyPointsRedeemInput{Reference: "order-2", Points: 100})
+		env.UpdateWorkflow(proto.CustomerLoyaltyPointsRedeemUpdate, "4", redeem, &proto.CustomerLoyaltyPointsRedeemInput{Reference: "order-3", Points: 10})
+		// Releasing more than was reserved only returns what was reserved.
+		env.UpdateWorkflow(proto.CustomerLoyaltyPointsReleaseUpdate, "5", release, &proto.CustomerLoyaltyPointsReleaseInput{Reference: "order-1", Points: 10})
+		env.UpdateWorkflow(proto.CustomerLoyaltyPointsReleaseUpdate, "6", release, &proto.CustomerLoyaltyPointsReleaseInput{Reference: "order-1", Points: 50})
+		env.UpdateWorkflow(proto.CustomerLoyaltyPointsReleaseUpdate, "7", release, &proto.CustomerLoyaltyPointsReleaseInput{Reference: "order-4", Points: 10})
+	}, time.Minute)
+
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(
+			proto.CustomerLoyaltyPointsEarnedSignal,
+			proto.CustomerLoyaltyPointsEarned{Points: 1},
+		)
+	}, time.Hour)
+
+	env.RegisterDelayedCallback(func() {
+		env.SetContinueAsNewSuggested(true)
+	}, 2*time.Hour)
+
+	env.ExecuteWorkflow(workflows.Customer, &proto.CustomerInput{Email: "test@example.com"}, nil)
+
+	assert.True(t, workflow.IsContinueAsNewError(env.GetWorkflowError()))
+	assert.Equal(t, []uint32{30, 30, 70}, reserved)
+	assert.Equal(t, []string{
+		workflows.CustomerNoPointsError,
+		workflows.CustomerReservationNotFoundError,
+	}, rejected)
+
+	v, err := env.QueryWorkflow(proto.CustomerLoyaltyPointsBalanceQuery)
+	assert.NoError(t, err)
+	var result proto.CustomerLoyaltyPointsBalance
+	err = v.Get(&result)
+	assert.NoError(t, err)
+
+	assert.Equal(t, uint32(31), result.Points)
+}
+
+func TestCustomerWorkflowTiersAndExpiry(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Customer)
+
+	day := 24 * time.Hour
+	program := &proto.LoyaltyProgram{
+		StartingBalance:  10,
+		PointsExpiryDays: 30,
+		TierWindowDays:   60,
+		Tiers: []*proto.LoyaltyTier{
+			{Name: "gold", Spend: 1000, Percent: 50},
+		},
+	}
+
+	balance := func() *proto.CustomerLoyaltyPointsBalance {
+		v, err := env.QueryWorkflow(proto.CustomerLoyaltyPointsBalanceQuery)
+		assert.NoError(t, err)
+		var result proto.CustomerLoyaltyPointsBalance
+		assert.NoError(t, v.Get(&result))
+		return &result
+	}
+
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(
+			proto.CustomerLoyaltyPointsEarnedSignal,
+			&proto.CustomerLoyaltyPointsEarned{Points: 10, Spend: 1000, Program: program},
+		)
+	}, day)
+
+	env.RegisterDelayedCallback(func() {
+		// Reaching gold earns half as many points again.
+		b := balance()
+		assert.Equal(t, "gold", b.Tier)
+		assert.Equal(t, uint32(25), b.Points)
+		assert.Equal(t, uint32(10), b.ExpiringPoints)
+	}, 20*day)
+
+	env.RegisterDelayedCallback(func() {
+		// The starting balance has expired.
+		b := balance()
+		assert.Equal(t, uint32(15), b.Points)
+		assert.Equal(t, "gold", b.Tier)
+	}, 30*day+time.Hour)
+
+	env.RegisterDelayedCallback(func() {
+		// The spend has left the tier window, so no bonus is earned.
+		env.SignalWorkflow(
+			proto.CustomerLoyaltyPointsEarnedSignal,
+			&proto.CustomerLoyaltyPointsEarned{Points: 10, Program: program},
+		)
+	}, 62*day)
+
+	env.RegisterDelayedCallback(func() {
+		env.SetContinueAsNewSuggested(true)
+	}, 63*day)
+
+	env.ExecuteWorkflow(workflows.Customer, &proto.CustomerInput{Email: "test@example.com", Program: program}, nil)
+
+	assert.True(t, workflow.IsContinueAsNewError(env.GetWorkflowError()))
+
+	b := balance()
+	assert.Equal(t, "", b.Tier)
+	assert.Equal(t, uint32(0), b.Spend)
+	assert.Equal(t, uint32(10), b.Points)
+}
+
+func TestCustomerWorkflowExpiryContinue(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Customer)
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	env.SetStartTime(start)
+
+	env.RegisterDelayedCallback(func() {
+		env.SetContinueAsNewSuggested(true)
+	}, 2*time.Hour)
+
+	// Expiry carried over from an earlier run is still enforced.
+	env.ExecuteWorkflow(workflows.Customer, &proto.CustomerInput{Email: "test@example.com"}, &workflows.CustomerWorkflowState{
+		Points: 8,
+		Lots: []workflows.CustomerPointsLot{
+			{Points: 5, Expires: start.Add(time.Hour)},
+			{Points: 3, Expires: start.Add(48 * time.Hour)},
+		},
+	})
+
+	assert.True(t, workflow.IsContinueAsNewError(env.GetWorkflowError()))
+
+	v, err := env.QueryWorkflow(proto.CustomerLoyaltyPointsBalanceQuery)
+	assert.NoError(t, err)
+	var result proto.CustomerLoyaltyPointsBalance
+	assert.NoError(t, v.Get(&result))
+
+	assert.Equal(t, uint32(3), result.Points)
+	assert.Equal(t, uint32(3), result.ExpiringPoints)
+}
+
+func TestCustomerWorkflowAdjust(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Customer)
+
+	var rejected []string
+	adjust := &updateCallback{
+		reject: func(err error) {
+			var appErr *temporal.ApplicationError
+			if assert.True(t, errors.As(err, &appErr)) {
+				rejected = append(rejected, appErr.Type())
+			}
+		},
+		complete: func(result interface{}, err error) {
+			assert.NoError(t, err)
+		},
+	}
+
+	env.RegisterDelayedCallback(func() {
+		env.UpdateWorkflow(proto.CustomerLoyaltyPointsAdjustUpdate, "1", adjust, &proto.CustomerLoyaltyPointsAdjustInput{Points: 20, Reason: "missed order", Reference: "a"})
+		// A retry of the same adjustment isn't applied again.
+		env.UpdateWorkflow(proto.CustomerLoyaltyPointsAdjustUpdate, "2", adjust, &proto.CustomerLoyaltyPointsAdjustInput{Points: 20, Reason: "missed order", Reference: "a"})
+		env.UpdateWorkflow(proto.CustomerLoyaltyPointsAdjustUpdate, "3", adjust, &proto.CustomerLoyaltyPointsAdjustInput{Points: 5})
+		env.UpdateWorkflow(proto.CustomerLoyaltyPointsAdjustUpdate, "4", adjust, &proto.CustomerLoyaltyPointsAdjustInput{Points: -500, Reason: "fraud"})
+		env.UpdateWorkflow(proto.CustomerLoyaltyPointsAdjustUpdate, "5", adjust, &proto.CustomerLoyaltyPointsAdjustInput{Points: -30, Reason: "duplicate credit", Reference: "b"})
+	}, time.Minute)
+
+	env.RegisterDelayedCallback(func() {
+		env.SetContinueAsNewSuggested(true)
+	}, time.Hour)
+
+	env.ExecuteWorkflow(workflows.Customer, &proto.CustomerInput{Email: "test@example.com"}, nil)
+
+	assert.True(t, workflow.IsContinueAsNewError(env.GetWorkflowError()))
+	assert.Equal(t, []string{
+		workflows.CustomerInvalidError,
+		workflows.CustomerNoPointsError,
+	}, rejected)
+
+	v, err := env.QueryWorkflow(proto.CustomerStatusQuery)
+	assert.NoError(t, err)
+	var status proto.CustomerStatus
+	assert.NoError(t, v.Get(&status))
+
+	assert.Equal(t, "test@example.com", status.Email)
+	assert.Equal(t, act.DefaultLoyaltyProgram().StartingBalance-10, status.Loyalty.Points)
+	if assert.Len(t, status.Transactions, 3) {
+		assert.Equal(t, proto.CustomerLoyaltyTransactionType_CUSTOMER_LOYALTY_TRANSACTION_TYPE_ADJUSTED, status.Transactions[0].Type)
+		assert.Equal(t, int32(-30), status.Transactions[0].Points)
+		assert.Equal(t, "duplicate credit", status.Transactions[0].Reason)
+		assert.Equal(t, int32(20), status.Transactions[1].Points)
+		assert.Equal(t, proto.CustomerLoyaltyTransactionType_CUSTOMER_LOYALTY_TRANSACTION_TYPE_STARTING_BALANCE, status.Transactions[2].Type)
+	}
+}
+
+func TestCustomerWorkflowLedger(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Customer)
+	env.RegisterActivity(activities.ArchiveCustomerLedger)
+
+	var archived []uint64
+	env.OnActivity(activities.ArchiveCustomerLedger, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.ArchiveCustomerLedgerInput) (*proto.ArchiveCustomerLedgerResult, error) {
+		assert.Equal(t, "test@example.com", input.Email)
+		for _, e := range input.Entries {
+			archived = append(archived, e.Seq)
+		}
+		return &proto.ArchiveCustomerLedgerResult{}, nil
+	})
+
+	adjust := &updateCallback{
+		reject: func(err error) {
+			assert.Fail(t, "unexpected rejection", err)
+		},
+	}
+
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(
+			proto.CustomerLoyaltyPointsEarnedSignal,
+			&proto.CustomerLoyaltyPointsEarned{Points: 5, OrderId: "order-1"},
+		)
+	}, time.Minute)
+
+	env.RegisterDelayedCallback(func() {
+		for i := 0; i < workflows.CustomerLedgerLimit; i++ {
+			env.UpdateWorkflow(proto.CustomerLoyaltyPointsAdjustUpdate, fmt.Sprint(i), adjust, &proto.CustomerLoyaltyPointsAdjustInput{Points: 1, Reason: "goodwill"})
+		}
+	}, time.Hour)
+
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(
+			proto.CustomerLoyaltyPointsEarnedSignal,
+			&proto.CustomerLoyaltyPointsEarned{Points: 5, OrderId: "order-2"},
+		)
+	}, 2*time.Hour)
+
+	env.RegisterDelayedCallback(func() {
+		env.SetContinueAsNewSuggested(true)
+	}, 3*time.Hour)
+
+	env.ExecuteWorkflow(workflows.Customer, &proto.CustomerInput{Email: "test@example.com"}, nil)
+
+	assert.True(t, workflow.IsContinueAsNewError(env.GetWorkflowError()))
+
+	// The starting balance, first order and adjustments went over the limit, so all but half the
+	// limit were archived, oldest first.
+	total := uint64(workflows.CustomerLedgerLimit + 3)
+	kept := uint64(workflows.CustomerLedgerLimit / 2)
+	if assert.Len(t, archived, int(total-1-kept)) {
+		assert.Equal(t, uint64(1), archived[0])
+	}
+
+	v, err := env.QueryWorkflow(proto.CustomerLedgerQuery, &proto.CustomerLedgerInput{Limit: 20})
+	assert.NoError(t, err)
+	var page proto.CustomerLedger
+	assert.NoError(t, v.Get(&page))
+
+	assert.Equal(t, total-kept, page.Archived)
+	if assert.Len(t, page.Entries, 20) {
+		assert.Equal(t, total, page.Entries[0].Seq)
+		assert.Equal(t, "order-2", page.Entries[0].OrderId)
+		assert.Equal(t, proto.CustomerLoyaltyTransactionType_CUSTOMER_LOYALTY_TRANSACTION_TYPE_EARNED, page.Entries[0].Type)
+		assert.Equal(t, total-19, page.Next)
+	}
+
+	v, err = env.QueryWorkflow(proto.CustomerLedgerQuery, &proto.CustomerLedgerInput{Before: total - 40, Limit: 20})
+	assert.NoError(t, err)
+	assert.NoError(t, v.Get(&page))
+
+	// The last page stops at the archived entries.
+	assert.Len(t, page.Entries, int(kept-40))
+	assert.Equal(t, uint64(0), page.Next)
+}
+
+func TestCustomerWorkflowCreditOnce(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Customer)
+
+	env.RegisterDelayedCallback(func() {
+		// A retried credit for order-1 and one already applied before continue-as-new for order-0.
+		env.SignalWorkflow(
+			proto.CustomerLoyaltyPointsEarnedSignal,
+			&proto.CustomerLoyaltyPointsEarned{Points: 3, Spend: 300, OrderId: "order-1"},
+		)
+		env.SignalWorkflow(
+			proto.CustomerLoyaltyPointsEarnedSignal,
+			&proto.CustomerLoyaltyPointsEarned{Points: 3, Spend: 300, OrderId: "order-1"},
+		)
+		env.SignalWorkflow(
+			proto.CustomerLoyaltyPointsEarnedSignal,
+			&proto.CustomerLoyaltyPointsEarned{Points: 2, Spend: 200, OrderId: "order-0"},
+		)
+	}, time.Minute)
+
+	env.RegisterDelayedCallback(func() {
+		env.SetContinueAsNewSuggested(true)
+	}, time.Hour)
+
+	env.ExecuteWorkflow(workflows.Customer, &proto.CustomerInput{Email: "test@example.com"}, &workflows.CustomerWorkflowState{
+		Points:         10,
+		CreditedOrders: []string{"order-0"},
+	})
+
+	assert.True(t, workflow.IsContinueAsNewError(env.GetWorkflowError()))
+
+	v, err := env.QueryWorkflow(proto.CustomerStatusQuery)
+	assert.NoError(t, err)
+	var status proto.CustomerStatus
+	assert.NoError(t, v.Get(&status))
+
+	assert.Equal(t, uint32(13), status.Loyalty.Points)
+	assert.Equal(t, uint32(300), status.Loyalty.Spend)
+	if assert.Len(t, status.Transactions, 1) {
+		assert.Equal(t, "order-1", status.Transactions[0].OrderId)
+	}
+}
+
+func TestCustomerWorkflowForget(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Customer)
+
+	state := &workflows.CustomerWorkflowState{
+		Points:         50,
+		CreditedOrders: []string{"order-1", "order-2"},
+		Reservations:   []workflows.CustomerPointsReservation{{Reference: "order-3/1", OrderID: "order-3", Points: 20}},
+		Transactions: []workflows.CustomerTransaction{
+			{Type: proto.CustomerLoyaltyTransactionType_CUSTOMER_LOYALTY_TRANSACTION_TYPE_EARNED, Points: 30, OrderID: "order-2"},
+			{Type: proto.CustomerLoyaltyTransactionType_CUSTOMER_LOYALTY_TRANSACTION_TYPE_REDEEMED, Points: -20, OrderID: "order-3"},
+		},
+	}
+
+	var forgotten [][]string
+	forget := &updateCallback{
+		complete: func(result interface{}, err error) {
+			assert.NoError(t, err)
+			forgotten = append(forgotten, result.(*proto.CustomerForgetResult).OrderIds)
+		},
+	}
+	var rejected []string
+	redeem := &updateCallback{
+		reject: func(err error) {
+			var appErr *temporal.ApplicationError
+			if assert.True(t, errors.As(err, &appErr)) {
+				rejected = append(rejected, appErr.Type())
+			}
+		},
+		complete: func(result interface{}, err error) {
+			assert.Fail(t, "redeemed points from a forgotten customer")
+		},
+	}
+
+	env.RegisterDelayedCallback(func() {
+		env.UpdateWorkflow(proto.CustomerForgetUpdate, "1", forget)
+		// Retries of the erasure get the same orders back.
+		env.UpdateWorkflow(proto.CustomerForgetUpdate, "2", forget)
+		env.UpdateWorkflow(proto.CustomerLoyaltyPointsRedeemUpdate, "3", redeem, &proto.CustomerLoyaltyPointsRedeemInput{Reference: "order-4/1", Points: 10})
+		// Points earned by an order which finished afterwards don't bring the account back.
+		env.SignalWorkflow(proto.CustomerLoyaltyPointsEarnedSignal, &proto.CustomerLoyaltyPointsEarned{Points: 5, Spend: 500, OrderId: "order-4"})
+	}, time.Minute)
+
+	env.ExecuteWorkflow(workflows.Customer, &proto.CustomerInput{Email: "test@example.com"}, state)
+
+	assert.True(t, env.IsWorkflowCompleted())
+	// The customer is done with rather than continuing as new.
+	assert.NoError(t, env.GetWorkflowError())
+
+	assert.Equal(t, [][]string{
+		{"order-1", "order-2", "order-3"},
+		{"order-1", "order-2", "order-3"},
+	}, forgotten)
+	assert.Equal(t, []string{workflows.CustomerForgottenError}, rejected)
+
+	v, err := env.QueryWorkflow(proto.CustomerStatusQuery)
+	assert.NoError(t, err)
+	var status proto.CustomerStatus
+	assert.NoError(t, v.Get(&status))
+
+	assert.Equal(t, uint32(0), status.Loyalty.Points)
+	assert.Equal(t, uint32(0), status.Loyalty.Spend)
+	assert.Empty(t, status.Transactions)
+}
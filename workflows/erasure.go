@@ -0,0 +1,68 @@
+package workflows
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/temporalio/temporal-cafe/proto"
+	"go.temporal.io/sdk/workflow"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CustomerErasureID returns the workflow ID for erasing a customer, identified by their subject in
+// the audit log so that their email does not appear in the workflow list. Loyalty points earned by
+// a customer with an erasure workflow are refused, rather than opening a new account for them.
+func CustomerErasureID(subject string) string {
+	return fmt.Sprintf("customer-erasure:%s", subject)
+}
+
+// CustomerErasure forgets a customer. Their loyalty account is closed and deleted, and their details
+// are removed from the running orders it refers to. Closed orders are kept as a record of their
+// payment. The audit is recorded once their orders have been dealt with. This workflow's own history
+// holds the email until the namespace's retention period removes it.
+func CustomerErasure(ctx workflow.Context, input *proto.CustomerErasureInput) (*proto.CustomerErasureResult, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+	})
+
+	audit := &proto.CustomerErasureAudit{
+		Subject: input.Subject,
+		Reason:  input.Reason,
+		Time:    timestamppb.New(workflow.Now(ctx)),
+	}
+
+	var customer proto.ForgetCustomerResult
+	err := workflow.ExecuteActivity(ctx, a.ForgetCustomer, &proto.ForgetCustomerInput{Email: input.Email}).Get(ctx, &customer)
+	if err != nil {
+		return nil, err
+	}
+	audit.CustomerFound = customer.Found
+
+	for _, id := range customer.OrderIds {
+		var order proto.ForgetOrderResult
+		err := workflow.ExecuteActivity(ctx, a.ForgetOrder, &proto.ForgetOrderInput{OrderId: id}).Get(ctx, &order)
+		if err != nil {
+			return nil, err
+		}
+		if order.Running {
+			audit.OrdersScrubbed = append(audit.OrdersScrubbed, id)
+		}
+		if order.Retained {
+			audit.OrdersRetained = append(audit.OrdersRetained, id)
+		}
+	}
+
+	err = workflow.ExecuteActivity(ctx, a.RecordCustomerErasure, &proto.RecordCustomerErasureInput{Audit: audit}).Get(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var records proto.DeleteCustomerRecordsResult
+	err = workflow.ExecuteActivity(ctx, a.DeleteCustomerRecords, &proto.DeleteCustomerRecordsInput{Email: input.Email}).Get(ctx, &records)
+	if err != nil {
+		return nil, err
+	}
+	workflow.GetLogger(ctx).Info("customer erased", "subject", audit.Subject, "runsDeleted", records.RunsDeleted)
+
+	return &proto.CustomerErasureResult{Audit: audit}, nil
+}
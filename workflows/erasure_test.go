@@ -0,0 +1,84 @@
+package workflows_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	act "github.com/temporalio/temporal-cafe/activities"
+	"github.com/temporalio/temporal-cafe/proto"
+	"github.com/temporalio/temporal-cafe/workflows"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestCustomerErasureWorkflow(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.CustomerErasure)
+	env.RegisterActivity(activities.ForgetCustomer)
+	env.RegisterActivity(activities.ForgetOrder)
+	env.RegisterActivity(activities.RecordCustomerErasure)
+	env.RegisterActivity(activities.DeleteCustomerRecords)
+
+	env.OnActivity(activities.ForgetCustomer, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.ForgetCustomerInput) (*proto.ForgetCustomerResult, error) {
+		assert.Equal(t, "test@example.com", input.Email)
+		return &proto.ForgetCustomerResult{Found: true, OrderIds: []string{"order-1", "order-2", "order-3"}}, nil
+	})
+	env.OnActivity(activities.ForgetOrder, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.ForgetOrderInput) (*proto.ForgetOrderResult, error) {
+		switch input.OrderId {
+		case "order-1":
+			return &proto.ForgetOrderResult{Running: true}, nil
+		case "order-2":
+			return &proto.ForgetOrderResult{Retained: true}, nil
+		}
+		// Orders which have already gone are neither.
+		return &proto.ForgetOrderResult{}, nil
+	})
+	var recorded []*proto.CustomerErasureAudit
+	env.OnActivity(activities.RecordCustomerErasure, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.RecordCustomerErasureInput) (*proto.RecordCustomerErasureResult, error) {
+		recorded = append(recorded, input.Audit)
+		return &proto.RecordCustomerErasureResult{}, nil
+	})
+	env.OnActivity(activities.DeleteCustomerRecords, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.DeleteCustomerRecordsInput) (*proto.DeleteCustomerRecordsResult, error) {
+		assert.Equal(t, "test@example.com", input.Email)
+		return &proto.DeleteCustomerRecordsResult{RunsDeleted: 2}, nil
+	})
+
+	var activityCalls []string
+	env.SetOnActivityStartedListener(func(activityInfo *activity.Info, ctx context.Context, args converter.EncodedValues) {
+		activityCalls = append(activityCalls, activityInfo.ActivityType.Name)
+	})
+
+	subject := act.ErasureSubject([]byte("secret"), "test@example.com")
+	env.ExecuteWorkflow(workflows.CustomerErasure, &proto.CustomerErasureInput{Email: "test@example.com", Reason: "customer request", Subject: subject})
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+
+	// The erasure is recorded once the customer's orders have been dealt with.
+	assert.Equal(t, []string{
+		"ForgetCustomer",
+		"ForgetOrder",
+		"ForgetOrder",
+		"ForgetOrder",
+		"RecordCustomerErasure",
+		"DeleteCustomerRecords",
+	}, activityCalls)
+
+	var result proto.CustomerErasureResult
+	assert.NoError(t, env.GetWorkflowResult(&result))
+
+	assert.Equal(t, subject, result.Audit.Subject)
+	assert.NotContains(t, result.Audit.Subject, "test@example.com")
+	assert.Equal(t, "customer request", result.Audit.Reason)
+	assert.True(t, result.Audit.CustomerFound)
+	assert.Equal(t, []string{"order-1"}, result.Audit.OrdersScrubbed)
+	assert.Equal(t, []string{"order-2"}, result.Audit.OrdersRetained)
+	if assert.Len(t, recorded, 1) {
+		assert.Equal(t, result.Audit.Subject, recorded[0].Subject)
+		assert.True(t, recorded[0].CustomerFound)
+	}
+}
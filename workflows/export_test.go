@@ -0,0 +1,41 @@
+package workflows
+
+import (
+	"fmt"
+
+	"github.com/temporalio/temporal-cafe/proto"
+	"go.temporal.io/sdk/workflow"
+)
+
+// StationOrderItemStatusTestSignal changes the status of an item in a station order run by
+// StationOrderWithItemSignals.
+const StationOrderItemStatusTestSignal = "test-station-order-item-status"
+
+// StationOrderWithItemSignals is StationOrder for tests of the workflows which start station
+// orders. The test environment only delivers updates to the workflow under test, so changes to
+// items are signalled instead and go through the update's validator and handler. Like staff, the
+// signals only act on the order once its queue has let it through. A rejected change fails the
+// station order.
+func StationOrderWithItemSignals(ctx workflow.Context, input *proto.StationOrderInput) (*proto.StationOrderResult, error) {
+	wf := newStationOrder(ctx, input)
+
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		ch := workflow.GetSignalChannel(ctx, StationOrderItemStatusTestSignal)
+		for {
+			var signal proto.StationOrderItemStatusInput
+			ch.Receive(ctx, &signal)
+
+			if err := workflow.Await(ctx, func() bool { return wf.Status.Admitted }); err != nil {
+				return
+			}
+			if err := wf.validateItemStatus(ctx, &signal); err != nil {
+				wf.err = fmt.Errorf("item status rejected: %w", err)
+				wf.changed.SendAsync(nil)
+				return
+			}
+			_, _ = wf.handleItemStatus(ctx, &signal)
+		}
+	})
+
+	return wf.run(ctx)
+}
@@ -0,0 +1,211 @@
+package workflows
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/temporalio/temporal-cafe/proto"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GiftCardValidity is how long a gift card can be used for if it is issued without an expiry
+const GiftCardValidity = 365 * 24 * time.Hour
+
+// GiftCardRedemptionLimit is the number of redemptions remembered by a gift card
+const GiftCardRedemptionLimit = 100
+
+// Error types used when rejecting gift card updates
+const (
+	GiftCardInvalidError   = "GiftCardInvalid"
+	GiftCardExpiredError   = "GiftCardExpired"
+	GiftCardNoBalanceError = "GiftCardNoBalance"
+	GiftCardNotFoundError  = "GiftCardNotFound"
+)
+
+// GiftCardID returns the workflow ID for a gift card number
+func GiftCardID(number string) string {
+	return fmt.Sprintf("gift-card:%s", number)
+}
+
+type GiftCardRedemption struct {
+	Reference string
+	Amount    uint32
+	Refunded  uint32
+}
+
+type GiftCardState struct {
+	Number      string
+	Balance     uint32
+	Expires     time.Time
+	Expired     bool
+	Redemptions []GiftCardRedemption
+}
+
+// NewGiftCardState creates a workflow state
+func NewGiftCardState(ctx workflow.Context, input *proto.GiftCardInput, state *GiftCardState) *GiftCardState {
+	if state != nil {
+		return state
+	}
+
+	expires := workflow.Now(ctx).Add(GiftCardValidity)
+	if input.Expires != nil {
+		expires = input.Expires.AsTime()
+	}
+
+	return &GiftCardState{Number: input.Number, Balance: input.Balance, Expires: expires}
+}
+
+func (s *GiftCardState) status() *proto.GiftCardStatus {
+	return &proto.GiftCardStatus{
+		Number:  s.Number,
+		Balance: s.Balance,
+		Expires: timestamppb.New(s.Expires),
+		Expired: s.Expired,
+	}
+}
+
+func (s *GiftCardState) find(reference string) int {
+	for i, r := range s.Redemptions {
+		if r.Reference == reference {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (s *GiftCardState) validateTopUp(ctx workflow.Context, input *proto.GiftCardTopUpInput) error {
+	if input.Amount == 0 {
+		return temporal.NewApplicationError("top up amount must be greater than zero", GiftCardInvalidError)
+	}
+	if s.Expired {
+		return temporal.NewApplicationError("gift card has expired", GiftCardExpiredError)
+	}
+
+	return nil
+}
+
+func (s *GiftCardState) handleTopUp(ctx workflow.Context, input *proto.GiftCardTopUpInput) (*proto.GiftCardStatus, error) {
+	s.Balance += input.Amount
+
+	return s.status(), nil
+}
+
+func (s *GiftCardState) validateRedeem(ctx workflow.Context, input *proto.GiftCardRedeemInput) error {
+	if input.Reference == "" {
+		return temporal.NewApplicationError("redemption has no reference", GiftCardInvalidError)
+	}
+	// Retries of a redemption we have already made are answered from the record of it.
+	if s.find(input.Reference) != -1 {
+		return nil
+	}
+	if s.Expired {
+		return temporal.NewApplicationError("gift card has expired", GiftCardExpiredError)
+	}
+	if s.Balance == 0 {
+		return temporal.NewApplicationError("gift card has no balance", GiftCardNoBalanceError)
+	}
+
+	return nil
+}
+
+// handleRedeem takes up to the requested amount from the card.
+func (s *GiftCardState) handleRedeem(ctx workflow.Context, input *proto.GiftCardRedeemInput) (*proto.GiftCardRedeemResult, error) {
+	if i := s.find(input.Reference); i != -1 {
+		return &proto.GiftCardRedeemResult{Amount: s.Redemptions[i].Amount, Balance: s.Balance}, nil
+	}
+
+	amount := input.Amount
+	if amount > s.Balance {
+		amount = s.Balance
+	}
+	s.Balance -= amount
+
+	s.Redemptions = append(s.Redemptions, GiftCardRedemption{Reference: input.Reference, Amount: amount})
+	if len(s.Redemptions) > GiftCardRedemptionLimit {
+		s.Redemptions = s.Redemptions[len(s.Redemptions)-GiftCardRedemptionLimit:]
+	}
+
+	return &proto.GiftCardRedeemResult{Amount: amount, Balance: s.Balance}, nil
+}
+
+func (s *GiftCardState) validateRefund(ctx workflow.Context, input *proto.GiftCardRefundInput) error {
+	if s.find(input.Reference) == -1 {
+		return temporal.NewApplicationError(fmt.Sprintf("redemption not found: %s", input.Reference), GiftCardNotFoundError)
+	}
+
+	return nil
+}
+
+// handleRefund returns up to amount of a redemption to the card. Refunds are allowed after the
+// card has expired so that orders can always be compensated.
+func (s *GiftCardState) handleRefund(ctx workflow.Context, input *proto.GiftCardRefundInput) (*proto.GiftCardStatus, error) {
+	r := &s.Redemptions[s.find(input.Reference)]
+
+	amount := input.Amount
+	if amount > r.Amount-r.Refunded {
+		amount = r.Amount - r.Refunded
+	}
+	r.Refunded += amount
+	s.Balance += amount
+
+	return s.status(), nil
+}
+
+// expire marks the card as expired once its expiry time has passed.
+func (s *GiftCardState) expire(ctx workflow.Context) {
+	if d := s.Expires.Sub(workflow.Now(ctx)); d > 0 {
+		if err := workflow.Sleep(ctx, d); err != nil {
+			return
+		}
+	}
+
+	s.Expired = true
+}
+
+func GiftCard(ctx workflow.Context, input *proto.GiftCardInput, state *GiftCardState) error {
+	wf := NewGiftCardState(ctx, input, state)
+
+	err := workflow.SetQueryHandler(ctx, proto.GiftCardStatusQuery, func() (*proto.GiftCardStatus, error) {
+		return wf.status(), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = workflow.SetUpdateHandlerWithOptions(ctx, proto.GiftCardTopUpUpdate, wf.handleTopUp, workflow.UpdateHandlerOptions{
+		Validator: wf.validateTopUp,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = workflow.SetUpdateHandlerWithOptions(ctx, proto.GiftCardRedeemUpdate, wf.handleRedeem, workflow.UpdateHandlerOptions{
+		Validator: wf.validateRedeem,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = workflow.SetUpdateHandlerWithOptions(ctx, proto.GiftCardRefundUpdate, wf.handleRefund, workflow.UpdateHandlerOptions{
+		Validator: wf.validateRefund,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !wf.Expired {
+		workflow.Go(ctx, wf.expire)
+	}
+
+	err = workflow.Await(ctx, func() bool {
+		return workflow.GetInfo(ctx).GetContinueAsNewSuggested()
+	})
+	if err != nil {
+		return err
+	}
+
+	return workflow.NewContinueAsNewError(ctx, GiftCard, input, wf)
+}
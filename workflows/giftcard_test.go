@@ -0,0 +1,119 @@
+package workflows_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/temporalio/temporal-cafe/proto"
+	"github.com/temporalio/temporal-cafe/workflows"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestGiftCardWorkflow(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.GiftCard)
+
+	var redeemed []uint32
+	redeem := &updateCallback{
+		reject: func(err error) {
+			assert.Fail(t, "unexpected rejection", err)
+		},
+		complete: func(result interface{}, err error) {
+			assert.NoError(t, err)
+			redeemed = append(redeemed, result.(*proto.GiftCardRedeemResult).Amount)
+		},
+	}
+	update := &updateCallback{
+		reject: func(err error) {
+			assert.Fail(t, "unexpected rejection", err)
+		},
+		complete: func(result interface{}, err error) {
+			assert.NoError(t, err)
+		},
+	}
+
+	env.RegisterDelayedCallback(func() {
+		env.UpdateWorkflow(proto.GiftCardRedeemUpdate, "1", redeem, &proto.GiftCardRedeemInput{Reference: "order-1", Amount: 800})
+		// A retry of the same redemption doesn't take any more from the card.
+		env.UpdateWorkflow(proto.GiftCardRedeemUpdate, "2", redeem, &proto.GiftCardRedeemInput{Reference: "order-1", Amount: 800})
+		// Only what is left on the card can be redeemed.
+		env.UpdateWorkflow(proto.GiftCardRedeemUpdate, "3", redeem, &proto.GiftCardRedeemInput{Reference: "order-2", Amount: 500})
+		env.UpdateWorkflow(proto.GiftCardRefundUpdate, "4", update, &proto.GiftCardRefundInput{Reference: "order-1", Amount: 300})
+		env.UpdateWorkflow(proto.GiftCardTopUpUpdate, "5", update, &proto.GiftCardTopUpInput{Amount: 1000})
+	}, time.Minute)
+
+	env.RegisterDelayedCallback(func() {
+		env.SetContinueAsNewSuggested(true)
+	}, time.Hour)
+
+	env.ExecuteWorkflow(workflows.GiftCard, &proto.GiftCardInput{Number: "1234", Balance: 1000}, nil)
+
+	assert.True(t, workflow.IsContinueAsNewError(env.GetWorkflowError()))
+	assert.Equal(t, []uint32{800, 800, 200}, redeemed)
+
+	v, err := env.QueryWorkflow(proto.GiftCardStatusQuery)
+	assert.NoError(t, err)
+	var status proto.GiftCardStatus
+	assert.NoError(t, v.Get(&status))
+
+	assert.Equal(t, "1234", status.Number)
+	assert.Equal(t, uint32(1300), status.Balance)
+	assert.False(t, status.Expired)
+}
+
+func TestGiftCardWorkflowExpiry(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.GiftCard)
+
+	var rejected []string
+	update := &updateCallback{
+		reject: func(err error) {
+			var appErr *temporal.ApplicationError
+			if assert.True(t, errors.As(err, &appErr)) {
+				rejected = append(rejected, appErr.Type())
+			}
+		},
+		complete: func(result interface{}, err error) {
+			assert.NoError(t, err)
+		},
+	}
+
+	env.RegisterDelayedCallback(func() {
+		env.UpdateWorkflow(proto.GiftCardRedeemUpdate, "1", update, &proto.GiftCardRedeemInput{Reference: "order-1", Amount: 500})
+	}, time.Minute)
+
+	env.RegisterDelayedCallback(func() {
+		env.UpdateWorkflow(proto.GiftCardRedeemUpdate, "2", update, &proto.GiftCardRedeemInput{Reference: "order-2", Amount: 500})
+		env.UpdateWorkflow(proto.GiftCardTopUpUpdate, "3", update, &proto.GiftCardTopUpInput{Amount: 500})
+		// Redemptions made before expiry can still be refunded.
+		env.UpdateWorkflow(proto.GiftCardRefundUpdate, "4", update, &proto.GiftCardRefundInput{Reference: "order-1", Amount: 500})
+		env.UpdateWorkflow(proto.GiftCardRefundUpdate, "5", update, &proto.GiftCardRefundInput{Reference: "order-3", Amount: 500})
+
+		env.SetContinueAsNewSuggested(true)
+	}, workflows.GiftCardValidity+time.Hour)
+
+	env.ExecuteWorkflow(workflows.GiftCard, &proto.GiftCardInput{Number: "1234", Balance: 1000}, nil)
+
+	assert.True(t, workflow.IsContinueAsNewError(env.GetWorkflowError()))
+	assert.Equal(t, []string{
+		workflows.GiftCardExpiredError,
+		workflows.GiftCardExpiredError,
+		workflows.GiftCardNotFoundError,
+	}, rejected)
+
+	v, err := env.QueryWorkflow(proto.GiftCardStatusQuery)
+	assert.NoError(t, err)
+	var status proto.GiftCardStatus
+	assert.NoError(t, v.Get(&status))
+
+	assert.True(t, status.Expired)
+	assert.Equal(t, uint32(1000), status.Balance)
+}
@@ -0,0 +1,272 @@
+package workflows
+
+import (
+	"fmt"
+
+	"github.com/temporalio/temporal-cafe/proto"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// MenuID is the workflow ID of the menu
+const MenuID = "menu"
+
+// Error types used when rejecting menu updates
+const (
+	MenuItemInvalidError  = "MenuItemInvalid"
+	MenuItemExistsError   = "MenuItemExists"
+	MenuItemNotFoundError = "MenuItemNotFound"
+)
+
+var (
+	sizeModifiers = &proto.MenuModifierGroup{
+		Name: "Size", Min: 0, Max: 1,
+		Modifiers: []*proto.MenuModifier{{Name: "Large", PriceDelta: 50}},
+	}
+	milkModifiers = &proto.MenuModifierGroup{
+		Name: "Milk", Min: 0, Max: 1,
+		Modifiers: []*proto.MenuModifier{{Name: "Oat", PriceDelta: 60}, {Name: "Almond", PriceDelta: 60}},
+	}
+	extraModifiers = &proto.MenuModifierGroup{
+		Name: "Extras", Min: 0, Max: 1,
+		Modifiers: []*proto.MenuModifier{{Name: "Extra Shot", PriceDelta: 75}},
+	}
+	bagelModifiers = &proto.MenuModifierGroup{
+		Name: "Filling", Min: 0, Max: 2,
+		Modifiers: []*proto.MenuModifier{{Name: "Cream Cheese", PriceDelta: 80}, {Name: "Smoked Salmon", PriceDelta: 250}},
+	}
+)
+
+// DefaultMenuItems are the items on the menu when it is first started
+var DefaultMenuItems = []*proto.MenuItem{
+	{
+		Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "Coffee", Price: 300, Category: "Hot Drinks", Available: true,
+		ModifierGroups: []*proto.MenuModifierGroup{sizeModifiers, milkModifiers, extraModifiers},
+	},
+	{
+		Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "Latte", Price: 350, Category: "Hot Drinks", Available: true,
+		ModifierGroups: []*proto.MenuModifierGroup{sizeModifiers, milkModifiers, extraModifiers},
+	},
+	{
+		Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "Milkshake", Price: 450, Category: "Cold Drinks", Available: true,
+		ModifierGroups: []*proto.MenuModifierGroup{sizeModifiers},
+	},
+	{
+		Type: proto.ProductType_PRODUCT_TYPE_FOOD, Name: "Bagel", Price: 500, Category: "Breakfast", Available: true,
+		ModifierGroups: []*proto.MenuModifierGroup{bagelModifiers},
+	},
+	{Type: proto.ProductType_PRODUCT_TYPE_FOOD, Name: "Sandwich", Price: 600, Category: "Lunch", Available: true},
+}
+
+type MenuState struct {
+	Items []*proto.MenuItem
+}
+
+// NewMenuState creates a workflow state
+func NewMenuState(state *MenuState) *MenuState {
+	if state != nil {
+		return state
+	}
+
+	var items []*proto.MenuItem
+	for _, item := range DefaultMenuItems {
+		items = append(items, &proto.MenuItem{
+			Type:      item.Type,
+			Name:      item.Name,
+			Price:     item.Price,
+			Category:  item.Category,
+			Available: item.Available,
+			// Modifier groups are never changed in place, so they can be shared.
+			ModifierGroups: item.ModifierGroups,
+		})
+	}
+
+	return &MenuState{Items: items}
+}
+
+func (s *MenuState) find(name string) int {
+	for i, item := range s.Items {
+		if item.Name == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func validateMenuItem(item *proto.MenuItem) error {
+	if item.Name == "" {
+		return temporal.NewApplicationError("menu item has no name", MenuItemInvalidError)
+	}
+
+	switch item.Type {
+	case proto.ProductType_PRODUCT_TYPE_FOOD, proto.ProductType_PRODUCT_TYPE_BEVERAGE:
+	default:
+		return temporal.NewApplicationError(fmt.Sprintf("unknown type for menu item: %s", item.Name), MenuItemInvalidError)
+	}
+
+	groups := map[string]bool{}
+	for _, g := range item.ModifierGroups {
+		if g.Name == "" || groups[g.Name] {
+			return temporal.NewApplicationError(fmt.Sprintf("invalid modifier group for menu item: %s", item.Name), MenuItemInvalidError)
+		}
+		groups[g.Name] = true
+
+		if g.Max == 0 || g.Min > g.Max || int(g.Max) > len(g.Modifiers) {
+			return temporal.NewApplicationError(fmt.Sprintf("invalid limits for modifier group %s on menu item: %s", g.Name, item.Name), MenuItemInvalidError)
+		}
+
+		modifiers := map[string]bool{}
+		for _, m := range g.Modifiers {
+			if m.Name == "" || modifiers[m.Name] {
+				return temporal.NewApplicationError(fmt.Sprintf("invalid modifier in group %s on menu item: %s", g.Name, item.Name), MenuItemInvalidError)
+			}
+			modifiers[m.Name] = true
+		}
+	}
+
+	return nil
+}
+
+func (s *MenuState) validateAdd(ctx workflow.Context, item *proto.MenuItem) error {
+	if err := validateMenuItem(item); err != nil {
+		return err
+	}
+	if s.find(item.Name) != -1 {
+		return temporal.NewApplicationError(fmt.Sprintf("menu item already exists: %s", item.Name), MenuItemExistsError)
+	}
+
+	return nil
+}
+
+func (s *MenuState) handleAdd(ctx workflow.Context, item *proto.MenuItem) (*proto.Menu, error) {
+	s.Items = append(s.Items, item)
+
+	return &proto.Menu{Items: s.Items}, nil
+}
+
+func (s *MenuState) validateChange(ctx workflow.Context, item *proto.MenuItem) error {
+	if err := validateMenuItem(item); err != nil {
+		return err
+	}
+	if s.find(item.Name) == -1 {
+		return temporal.NewApplicationError(fmt.Sprintf("menu item not found: %s", item.Name), MenuItemNotFoundError)
+	}
+
+	return nil
+}
+
+// handleChange replaces a menu item. Its availability is kept, as that is only changed by the
+// stations through handleAvailability.
+func (s *MenuState) handleChange(ctx workflow.Context, item *proto.MenuItem) (*proto.Menu, error) {
+	i := s.find(item.Name)
+	item.Available = s.Items[i].Available
+	s.Items[i] = item
+
+	return &proto.Menu{Items: s.Items}, nil
+}
+
+func (s *MenuState) validateRemove(ctx workflow.Context, input *proto.MenuItemRemoveInput) error {
+	if s.find(input.Name) == -1 {
+		return temporal.NewApplicationError(fmt.Sprintf("menu item not found: %s", input.Name), MenuItemNotFoundError)
+	}
+
+	return nil
+}
+
+func (s *MenuState) handleRemove(ctx workflow.Context, input *proto.MenuItemRemoveInput) (*proto.Menu, error) {
+	i := s.find(input.Name)
+	s.Items = append(s.Items[:i], s.Items[i+1:]...)
+
+	return &proto.Menu{Items: s.Items}, nil
+}
+
+func (s *MenuState) validateAvailability(ctx workflow.Context, input *proto.MenuItemAvailabilityInput) error {
+	if s.find(input.Name) == -1 {
+		return temporal.NewApplicationError(fmt.Sprintf("menu item not found: %s", input.Name), MenuItemNotFoundError)
+	}
+
+	return nil
+}
+
+func (s *MenuState) handleAvailability(ctx workflow.Context, input *proto.MenuItemAvailabilityInput) (*proto.Menu, error) {
+	s.Items[s.find(input.Name)].Available = input.Available
+
+	return &proto.Menu{Items: s.Items}, nil
+}
+
+func (s *MenuState) validateReplace(ctx workflow.Context, menu *proto.Menu) error {
+	names := map[string]bool{}
+	for _, item := range menu.Items {
+		if err := validateMenuItem(item); err != nil {
+			return err
+		}
+		if names[item.Name] {
+			return temporal.NewApplicationError(fmt.Sprintf("menu item listed more than once: %s", item.Name), MenuItemExistsError)
+		}
+		names[item.Name] = true
+	}
+
+	return nil
+}
+
+func (s *MenuState) handleReplace(ctx workflow.Context, menu *proto.Menu) (*proto.Menu, error) {
+	s.Items = menu.Items
+
+	return &proto.Menu{Items: s.Items}, nil
+}
+
+func Menu(ctx workflow.Context, state *MenuState) error {
+	wf := NewMenuState(state)
+
+	err := workflow.SetQueryHandler(ctx, proto.MenuQuery, func() (*proto.Menu, error) {
+		return &proto.Menu{Items: wf.Items}, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = workflow.SetUpdateHandlerWithOptions(ctx, proto.MenuItemAddUpdate, wf.handleAdd, workflow.UpdateHandlerOptions{
+		Validator: wf.validateAdd,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = workflow.SetUpdateHandlerWithOptions(ctx, proto.MenuItemChangeUpdate, wf.handleChange, workflow.UpdateHandlerOptions{
+		Validator: wf.validateChange,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = workflow.SetUpdateHandlerWithOptions(ctx, proto.MenuItemRemoveUpdate, wf.handleRemove, workflow.UpdateHandlerOptions{
+		Validator: wf.validateRemove,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = workflow.SetUpdateHandlerWithOptions(ctx, proto.MenuItemAvailabilityUpdate, wf.handleAvailability, workflow.UpdateHandlerOptions{
+		Validator: wf.validateAvailability,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = workflow.SetUpdateHandlerWithOptions(ctx, proto.MenuReplaceUpdate, wf.handleReplace, workflow.UpdateHandlerOptions{
+		Validator: wf.validateReplace,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = workflow.Await(ctx, func() bool {
+		return workflow.GetInfo(ctx).GetContinueAsNewSuggested()
+	})
+	if err != nil {
+		return err
+	}
+
+	return workflow.NewContinueAsNewError(ctx, Menu, wf)
+}
@@ -0,0 +1,178 @@
+package workflows_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/temporalio/temporal-cafe/proto"
+	"github.com/temporalio/temporal-cafe/workflows"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func menuItemNames(menu *proto.Menu) []string {
+	var names []string
+	for _, item := range menu.Items {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+func TestMenuWorkflow(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Menu)
+
+	var menu *proto.Menu
+	update := &updateCallback{
+		reject: func(err error) {
+			assert.Fail(t, "unexpected rejection", err)
+		},
+		complete: func(result interface{}, err error) {
+			assert.NoError(t, err)
+			menu = result.(*proto.Menu)
+		},
+	}
+
+	env.RegisterDelayedCallback(func() {
+		env.UpdateWorkflow(proto.MenuItemAddUpdate, "1", update, &proto.MenuItem{
+			Type: proto.ProductType_PRODUCT_TYPE_FOOD, Name: "Croissant", Price: 350, Category: "Breakfast", Available: true,
+		})
+		env.UpdateWorkflow(proto.MenuItemChangeUpdate, "2", update, &proto.MenuItem{
+			Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "Latte", Price: 375, Category: "Hot Drinks", Available: true,
+		})
+		env.UpdateWorkflow(proto.MenuItemRemoveUpdate, "3", update, &proto.MenuItemRemoveInput{Name: "Milkshake"})
+	}, time.Minute)
+
+	env.RegisterDelayedCallback(func() {
+		v, err := env.QueryWorkflow(proto.MenuQuery)
+		assert.NoError(t, err)
+
+		var queried proto.Menu
+		assert.NoError(t, v.Get(&queried))
+		assert.Equal(t, []string{"Coffee", "Latte", "Bagel", "Sandwich", "Croissant"}, menuItemNames(&queried))
+		assert.Equal(t, uint32(375), queried.Items[1].Price)
+
+		env.SetContinueAsNewSuggested(true)
+	}, time.Hour)
+
+	env.ExecuteWorkflow(workflows.Menu, nil)
+
+	assert.True(t, workflow.IsContinueAsNewError(env.GetWorkflowError()))
+	assert.Equal(t, []string{"Coffee", "Latte", "Bagel", "Sandwich", "Croissant"}, menuItemNames(menu))
+}
+
+func TestMenuWorkflowRejected(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Menu)
+
+	var rejected []string
+	update := &updateCallback{
+		reject: func(err error) {
+			var appErr *temporal.ApplicationError
+			if assert.True(t, errors.As(err, &appErr)) {
+				rejected = append(rejected, appErr.Type())
+			}
+		},
+		complete: func(result interface{}, err error) {
+			assert.Fail(t, "unexpected completion")
+		},
+	}
+
+	env.RegisterDelayedCallback(func() {
+		env.UpdateWorkflow(proto.MenuItemAddUpdate, "1", update, &proto.MenuItem{
+			Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "Coffee", Price: 300,
+		})
+		env.UpdateWorkflow(proto.MenuItemAddUpdate, "2", update, &proto.MenuItem{Name: "Tea", Price: 250})
+		env.UpdateWorkflow(proto.MenuItemChangeUpdate, "3", update, &proto.MenuItem{
+			Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "Tea", Price: 250,
+		})
+		env.UpdateWorkflow(proto.MenuItemRemoveUpdate, "4", update, &proto.MenuItemRemoveInput{Name: "Tea"})
+		env.UpdateWorkflow(proto.MenuItemAddUpdate, "5", update, &proto.MenuItem{
+			Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "Tea", Price: 250,
+			ModifierGroups: []*proto.MenuModifierGroup{
+				{Name: "Milk", Min: 0, Max: 2, Modifiers: []*proto.MenuModifier{{Name: "Oat", PriceDelta: 60}}},
+			},
+		})
+		env.UpdateWorkflow(proto.MenuReplaceUpdate, "6", update, &proto.Menu{
+			Items: []*proto.MenuItem{
+				{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "Tea", Price: 250},
+				{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "Tea", Price: 300},
+			},
+		})
+	}, time.Minute)
+
+	env.RegisterDelayedCallback(func() {
+		env.SetContinueAsNewSuggested(true)
+	}, time.Hour)
+
+	env.ExecuteWorkflow(workflows.Menu, nil)
+
+	assert.True(t, workflow.IsContinueAsNewError(env.GetWorkflowError()))
+	assert.Equal(t, []string{
+		workflows.MenuItemExistsError,
+		workflows.MenuItemInvalidError,
+		workflows.MenuItemNotFoundError,
+		workflows.MenuItemNotFoundError,
+		workflows.MenuItemInvalidError,
+		workflows.MenuItemExistsError,
+	}, rejected)
+}
+
+func TestMenuWorkflowAvailability(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Menu)
+
+	var menu *proto.Menu
+	var rejected error
+	update := &updateCallback{
+		reject: func(err error) {
+			rejected = err
+		},
+		complete: func(result interface{}, err error) {
+			assert.NoError(t, err)
+			menu = result.(*proto.Menu)
+		},
+	}
+
+	env.RegisterDelayedCallback(func() {
+		env.UpdateWorkflow(proto.MenuItemAvailabilityUpdate, "1", update, &proto.MenuItemAvailabilityInput{Name: "Bagel", Available: false})
+		env.UpdateWorkflow(proto.MenuItemAvailabilityUpdate, "2", update, &proto.MenuItemAvailabilityInput{Name: "Croissant", Available: false})
+	}, time.Minute)
+
+	// Changing the price of a sold out item doesn't put it back on sale.
+	env.RegisterDelayedCallback(func() {
+		env.UpdateWorkflow(proto.MenuItemChangeUpdate, "3", update, &proto.MenuItem{
+			Type: proto.ProductType_PRODUCT_TYPE_FOOD, Name: "Bagel", Price: 550, Category: "Breakfast", Available: true,
+		})
+	}, 2*time.Minute)
+
+	env.RegisterDelayedCallback(func() {
+		env.SetContinueAsNewSuggested(true)
+	}, time.Hour)
+
+	env.ExecuteWorkflow(workflows.Menu, nil)
+
+	assert.True(t, workflow.IsContinueAsNewError(env.GetWorkflowError()))
+
+	var appErr *temporal.ApplicationError
+	if assert.True(t, errors.As(rejected, &appErr)) {
+		assert.Equal(t, workflows.MenuItemNotFoundError, appErr.Type())
+	}
+
+	var unavailable []string
+	for _, item := range menu.Items {
+		if !item.Available {
+			unavailable = append(unavailable, item.Name)
+		}
+	}
+	assert.Equal(t, []string{"Bagel"}, unavailable)
+}
@@ -1,28 +1,98 @@
 package workflows
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"sort"
 	"time"
 
+	"github.com/temporalio/temporal-cafe/activities"
 	"github.com/temporalio/temporal-cafe/proto"
 	workflowEnums "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
 )
 
 const OrderFulfilmentWindow = 15 * time.Minute
 
-func processPayment(ctx workflow.Context, token string) (*proto.ProcessPaymentResult, error) {
+// OrderLoyaltyCreditWindow is how long crediting loyalty points is retried before it is reported as failed
+const OrderLoyaltyCreditWindow = time.Hour
+
+var ErrOrderCancelled = errors.New("order cancelled")
+
+var ErrPaymentDeclined = errors.New("payment declined")
+
+var ErrOrderFailed = errors.New("no items in the order could be made")
+
+var ErrGiftCardDeclined = errors.New("gift card declined")
+
+var ErrPaymentIncomplete = errors.New("tenders do not cover the order total")
+
+var ErrLoyaltyPointsDeclined = errors.New("loyalty points declined")
+
+var ErrNoStation = errors.New("no station makes item")
+
+// ErrOrderTotalTooLarge is returned for an order whose total does not fit in a payment amount.
+var ErrOrderTotalTooLarge = errors.New("order total is too large")
+
+// MaxItemCount is the most of an item which can be ordered on a single line. Stations make each
+// unit separately, so this also bounds the work one line can create.
+const MaxItemCount = 100
+
+// authorizePayment places a hold on the customer's funds. Declines are returned as ErrPaymentDeclined,
+// gateway failures are retried until the payment window closes. Retries use the same reference, so
+// at most one hold is placed for it.
+func authorizePayment(ctx workflow.Context, reference string, token string, amount uint32) (*proto.AuthorizePaymentResult, error) {
 	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
-		StartToCloseTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    30 * time.Second,
+		ScheduleToCloseTimeout: 5 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			NonRetryableErrorTypes: []string{activities.PaymentDeclinedError},
+		},
 	})
 
-	var result proto.ProcessPaymentResult
-	err := workflow.ExecuteActivity(ctx, a.ProcessPayment, &proto.ProcessPaymentInput{Token: token}).Get(ctx, &result)
+	var result proto.AuthorizePaymentResult
+	err := workflow.ExecuteActivity(ctx, a.AuthorizePayment, &proto.AuthorizePaymentInput{Reference: reference, Token: token, Amount: amount}).Get(ctx, &result)
+
+	var appErr *temporal.ApplicationError
+	if errors.As(err, &appErr) && appErr.Type() == activities.PaymentDeclinedError {
+		return nil, ErrPaymentDeclined
+	}
 
 	return &result, err
 }
 
-func refundPayment(ctx workflow.Context, payment *proto.Payment) error {
+func capturePayment(ctx workflow.Context, payment *proto.Payment, amount uint32) error {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 5 * time.Minute,
+	})
+
+	err := workflow.ExecuteActivity(
+		ctx,
+		a.CapturePayment,
+		proto.CapturePaymentInput{Payment: payment, Amount: amount},
+	).Get(ctx, nil)
+
+	return err
+}
+
+func voidPayment(ctx workflow.Context, payment *proto.Payment) error {
+	ctx, _ = workflow.NewDisconnectedContext(ctx)
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 5 * time.Minute,
+	})
+
+	err := workflow.ExecuteActivity(
+		ctx,
+		a.VoidPayment,
+		proto.VoidPaymentInput{Payment: payment},
+	).Get(ctx, nil)
+
+	return err
+}
+
+func processPaymentRefund(ctx workflow.Context, payment *proto.Payment, amount uint32) error {
 	ctx, _ = workflow.NewDisconnectedContext(ctx)
 	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
 		StartToCloseTimeout: 5 * time.Minute,
@@ -31,54 +101,126 @@ func refundPayment(ctx workflow.Context, payment *proto.Payment) error {
 	err := workflow.ExecuteActivity(
 		ctx,
 		a.ProcessPaymentRefund,
-		proto.ProcessPaymentRefundInput{Payment: payment},
+		proto.ProcessPaymentRefundInput{Payment: payment, Amount: amount},
 	).Get(ctx, nil)
 
 	return err
 }
 
-func fulfilOrder(ctx workflow.Context, name string, items []*proto.OrderLineItem) workflow.Future {
-	future, settable := workflow.NewFuture(ctx)
-	itemsByType := make(map[proto.ProductType][]*proto.OrderLineItem)
+// redeemGiftCard takes up to amount from a gift card. Cards which can't be used are returned as
+// ErrGiftCardDeclined.
+func redeemGiftCard(ctx workflow.Context, number string, reference string, amount uint32) (*proto.RedeemGiftCardResult, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout:    30 * time.Second,
+		ScheduleToCloseTimeout: 5 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			NonRetryableErrorTypes: []string{activities.GiftCardDeclinedError},
+		},
+	})
 
-	for _, v := range items {
-		itemsByType[v.Type] = append(itemsByType[v.Type], v)
+	var result proto.RedeemGiftCardResult
+	err := workflow.ExecuteActivity(
+		ctx,
+		a.RedeemGiftCard,
+		&proto.RedeemGiftCardInput{Number: number, Reference: reference, Amount: amount},
+	).Get(ctx, &result)
+
+	var appErr *temporal.ApplicationError
+	if errors.As(err, &appErr) && appErr.Type() == activities.GiftCardDeclinedError {
+		return nil, fmt.Errorf("%w: %s", ErrGiftCardDeclined, appErr.Error())
 	}
 
+	return &result, err
+}
+
+func refundGiftCard(ctx workflow.Context, number string, reference string, amount uint32) error {
+	ctx, _ = workflow.NewDisconnectedContext(ctx)
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 5 * time.Minute,
+	})
+
+	err := workflow.ExecuteActivity(
+		ctx,
+		a.RefundGiftCard,
+		proto.RefundGiftCardInput{Number: number, Reference: reference, Amount: amount},
+	).Get(ctx, nil)
+
+	return err
+}
+
+// redeemLoyaltyPoints reserves up to points from the customer's loyalty account. Declines are
+// returned as ErrLoyaltyPointsDeclined.
+func redeemLoyaltyPoints(ctx workflow.Context, email string, orderID string, reference string, points uint32) (*proto.RedeemLoyaltyPointsResult, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout:    30 * time.Second,
+		ScheduleToCloseTimeout: 5 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			NonRetryableErrorTypes: []string{activities.LoyaltyPointsDeclinedError},
+		},
+	})
+
+	var result proto.RedeemLoyaltyPointsResult
+	err := workflow.ExecuteActivity(
+		ctx,
+		a.RedeemLoyaltyPoints,
+		&proto.RedeemLoyaltyPointsInput{Email: email, OrderId: orderID, Reference: reference, Points: points},
+	).Get(ctx, &result)
+
+	var appErr *temporal.ApplicationError
+	if errors.As(err, &appErr) && appErr.Type() == activities.LoyaltyPointsDeclinedError {
+		return nil, fmt.Errorf("%w: %s", ErrLoyaltyPointsDeclined, appErr.Error())
+	}
+
+	return &result, err
+}
+
+func releaseLoyaltyPoints(ctx workflow.Context, email string, reference string, points uint32) error {
+	ctx, _ = workflow.NewDisconnectedContext(ctx)
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 5 * time.Minute,
+	})
+
+	err := workflow.ExecuteActivity(
+		ctx,
+		a.ReleaseLoyaltyPoints,
+		proto.ReleaseLoyaltyPointsInput{Email: email, Reference: reference, Points: points},
+	).Get(ctx, nil)
+
+	return err
+}
+
+// fulfilOrder starts station workflows for the order's items. The returned future is ready once
+// every station, including any started by later modifications, has completed, or as soon as one
+// fails or the order is cancelled.
+func (o *OrderWorkflow) fulfilOrder(ctx workflow.Context, items []*proto.OrderLineItem) workflow.Future {
+	future, settable := workflow.NewFuture(ctx)
+
 	childCtx, cancelChildren := workflow.WithCancel(ctx)
 	childCtx = workflow.WithChildOptions(childCtx, workflow.ChildWorkflowOptions{
 		ParentClosePolicy: workflowEnums.PARENT_CLOSE_POLICY_REQUEST_CANCEL,
 	})
+	o.stationCtx = childCtx
+
+	stations, itemsByStation := o.groupItemsByStation(items)
+	for _, station := range stations {
+		o.startStation(childCtx, station, itemsByStation[station])
+	}
 
 	workflow.Go(childCtx, func(gctx workflow.Context) {
-		var err error
-
-		s := workflow.NewSelector(gctx)
-
-		for t, items := range itemsByType {
-			var cw interface{}
-			var input interface{}
-			switch t {
-			case proto.ProductType_PRODUCT_TYPE_FOOD:
-				cw = KitchenOrder
-				input = proto.KitchenOrderInput{Name: name, Items: items}
-			case proto.ProductType_PRODUCT_TYPE_BEVERAGE:
-				cw = BaristaOrder
-				input = proto.BaristaOrderInput{Name: name, Items: items}
+		err := workflow.Await(gctx, func() bool {
+			if o.cancelled.IsReady() || o.stationErr != nil {
+				return true
 			}
-			s.AddFuture(workflow.ExecuteChildWorkflow(gctx, cw, input), func(f workflow.Future) {
-				err = f.Get(gctx, nil)
-				if err != nil {
-					cancelChildren()
-				}
-			})
+			return o.pendingStations == 0 && o.modifying == 0
+		})
+		if err == nil && o.cancelled.IsReady() {
+			err = o.cancelled.Get(gctx, nil)
 		}
-
-		for i := 0; i < len(itemsByType); i++ {
-			s.Select(gctx)
-			if err != nil {
-				break
-			}
+		if err == nil {
+			err = o.stationErr
+		}
+		if err != nil {
+			cancelChildren()
 		}
 
 		settable.Set(nil, err)
@@ -87,6 +229,41 @@ func fulfilOrder(ctx workflow.Context, name string, items []*proto.OrderLineItem
 	return future
 }
 
+func (o *OrderWorkflow) startStation(ctx workflow.Context, station string, items []*proto.OrderLineItem) {
+	opts := workflow.GetChildWorkflowOptions(ctx)
+	opts.Memo = map[string]interface{}{StationMemo: station}
+	ctx = workflow.WithChildOptions(ctx, opts)
+
+	f := workflow.ExecuteChildWorkflow(ctx, StationOrder, &proto.StationOrderInput{Station: station, Name: o.Status.Name, Items: items, MaxRemakes: o.maxRemakes})
+	o.stations[station] = f
+	o.pendingStations++
+
+	workflow.Go(ctx, func(gctx workflow.Context) {
+		err := f.Get(gctx, nil)
+		o.pendingStations--
+		if err != nil && o.stationErr == nil {
+			o.stationErr = err
+		}
+	})
+}
+
+// updateStation forwards added or removed items to a station, starting a new station workflow if
+// the previous one has already finished.
+func (o *OrderWorkflow) updateStation(ctx workflow.Context, station string, add []*proto.OrderLineItem, remove []*proto.OrderLineItem) error {
+	f, ok := o.stations[station]
+	if ok && !f.IsReady() {
+		err := f.SignalChildWorkflow(ctx, proto.StationOrderItemsSignal, &proto.StationOrderItemsUpdate{Add: add, Remove: remove}).Get(ctx, nil)
+		if err == nil || len(add) == 0 {
+			return err
+		}
+	}
+	if len(add) > 0 {
+		o.startStation(o.stationCtx, station, add)
+	}
+
+	return nil
+}
+
 func fulfilmentTimer(ctx workflow.Context) workflow.Future {
 	future, settable := workflow.NewFuture(ctx)
 
@@ -101,43 +278,207 @@ func fulfilmentTimer(ctx workflow.Context) workflow.Future {
 	return future
 }
 
-func calculateLoyaltyPoints(input *proto.OrderInput) uint32 {
-	var i uint32 = 0
-
-	for _, item := range input.Items {
-		i += item.Count
-	}
-
-	return i
-}
-
-func addLoyaltyPoints(ctx workflow.Context, input *proto.OrderInput) error {
+// addLoyaltyPoints credits the customer for the items they paid for, returning the points earned
+// under the loyalty program before any tier bonus. The customer only credits each order once, so
+// the activity can safely be retried.
+func addLoyaltyPoints(ctx workflow.Context, email string, orderID string, items []*proto.OrderLineItem, refunds []*proto.OrderLineItem, spend uint32) (uint32, error) {
 	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
-		StartToCloseTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		ScheduleToCloseTimeout: OrderLoyaltyCreditWindow,
 	})
 
-	points := calculateLoyaltyPoints(input)
+	var result proto.AddLoyaltyPointsResult
 	err := workflow.ExecuteActivity(
 		ctx,
 		a.AddLoyaltyPoints,
-		proto.AddLoyaltyPointsInput{Email: input.Email, Points: points},
-	).Get(ctx, nil)
+		proto.AddLoyaltyPointsInput{Email: email, OrderId: orderID, Items: items, Refunds: refunds, Spend: spend},
+	).Get(ctx, &result)
 
-	return err
+	return result.Points, err
+}
+
+// orderTotal is computed in 64 bits so that large orders can be detected rather than wrapping.
+func orderTotal(items []*proto.OrderLineItem) uint64 {
+	var total uint64 = 0
+
+	for _, item := range items {
+		total += uint64(item.Price) * uint64(item.Count)
+	}
+
+	return total
+}
+
+// itemStation returns the station which makes an item. Items are routed when they are ordered, so
+// an item without a station takes the one already chosen for the same item on the order, falling
+// back to the default routing for orders placed without one.
+func (o *OrderWorkflow) itemStation(li *proto.OrderLineItem) string {
+	if li.Station != "" {
+		return li.Station
+	}
+	for _, v := range o.Status.Items {
+		if v.Station != "" && sameItem(v, li) {
+			return v.Station
+		}
+	}
+
+	return StationFor(DefaultStationRouting(), li.Type, "", li.Name)
+}
+
+// groupItemsByStation returns the stations which make the items in the order they are first needed,
+// along with the items for each station.
+func (o *OrderWorkflow) groupItemsByStation(items []*proto.OrderLineItem) ([]string, map[string][]*proto.OrderLineItem) {
+	var stations []string
+	itemsByStation := make(map[string][]*proto.OrderLineItem)
+
+	for _, v := range items {
+		station := o.itemStation(v)
+		if _, ok := itemsByStation[station]; !ok {
+			stations = append(stations, station)
+		}
+		itemsByStation[station] = append(itemsByStation[station], v)
+	}
+
+	return stations, itemsByStation
+}
+
+func sameItem(a *proto.OrderLineItem, b *proto.OrderLineItem) bool {
+	return a.Type == b.Type && a.Name == b.Name && a.Price == b.Price &&
+		sameModifiers(modifierNames(a.Modifiers), modifierNames(b.Modifiers))
+}
+
+// modifierNames returns the names of the modifiers on an item, as shown to stations.
+func modifierNames(modifiers []*proto.OrderLineItemModifier) []string {
+	var names []string
+	for _, m := range modifiers {
+		names = append(names, m.Name)
+	}
+
+	return names
+}
+
+func sameModifiers(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+type OrderWorkflow struct {
+	Status *proto.OrderStatus
+
+	tenders []*orderTender
+
+	maxRemakes uint32
+
+	stationCtx      workflow.Context
+	stations        map[string]workflow.ChildWorkflowFuture
+	pendingStations int
+	stationErr      error
+	modifying       int
+	// fulfilled is set once the stations have finished or run out of time. The order can no
+	// longer be changed, as its payment is being settled.
+	fulfilled bool
+
+	cancelled workflow.Future
+	cancel    workflow.Settable
+}
+
+func NewOrderWorkflow(input *proto.OrderInput) *OrderWorkflow {
+	wf := &OrderWorkflow{
+		Status:     &proto.OrderStatus{Name: input.Name, Open: true},
+		stations:   make(map[string]workflow.ChildWorkflowFuture),
+		maxRemakes: input.MaxRemakes,
+	}
+	for _, t := range orderTenders(input) {
+		wf.addTender(t)
+	}
+	wf.addItems(input.Items)
+	// A total which does not fit is refused by process before payment is taken.
+	wf.Status.Total = uint32(orderTotal(wf.Status.Items))
+
+	return wf
 }
 
 func Order(ctx workflow.Context, input *proto.OrderInput) (*proto.OrderResult, error) {
-	p, err := processPayment(ctx, input.PaymentToken)
+	wf := NewOrderWorkflow(input)
+
+	err := workflow.SetQueryHandler(ctx, proto.OrderStatusQuery, func() (*proto.OrderStatus, error) {
+		return wf.Status, nil
+	})
+	if err != nil {
+		return &proto.OrderResult{}, err
+	}
+
+	wf.cancelled, wf.cancel = workflow.NewFuture(ctx)
+
+	err = workflow.SetUpdateHandlerWithOptions(ctx, proto.OrderCancelUpdate, wf.handleCancel, workflow.UpdateHandlerOptions{
+		Validator: wf.validateCancel,
+	})
 	if err != nil {
 		return &proto.OrderResult{}, err
 	}
+
+	err = workflow.SetUpdateHandlerWithOptions(ctx, proto.OrderModifyUpdate, wf.handleModify, workflow.UpdateHandlerOptions{
+		Validator: wf.validateModify,
+	})
+	if err != nil {
+		return &proto.OrderResult{}, err
+	}
+
+	wf.watchStations(ctx)
+	wf.watchCustomer(ctx, input)
+
+	err = wf.process(ctx, input)
+
+	wf.Status.Open = false
+	if err != nil {
+		wf.Status.Error = err.Error()
+	}
+
+	return &proto.OrderResult{}, err
+}
+
+func (o *OrderWorkflow) process(ctx workflow.Context, input *proto.OrderInput) error {
+	// Don't take payment for an order which can't be made.
+	for _, li := range o.Status.Items {
+		if li.Station == "" {
+			return fmt.Errorf("%w: %s", ErrNoStation, li.Name)
+		}
+		if li.Count == 0 || li.Count > MaxItemCount {
+			return fmt.Errorf("invalid count for item: %s", li.Name)
+		}
+	}
+	if orderTotal(o.Status.Items) > math.MaxUint32 {
+		return ErrOrderTotalTooLarge
+	}
+
+	err := o.pay(ctx)
+	if err != nil {
+		o.Status.Payment = proto.OrderPaymentStatus_ORDER_PAYMENT_STATUS_FAILED
+		return err
+	}
+	o.Status.Payment = proto.OrderPaymentStatus_ORDER_PAYMENT_STATUS_AUTHORIZED
 	defer func() {
 		if err != nil {
-			refundPayment(ctx, p.Payment)
+			if o.releaseAll(ctx) == nil {
+				o.Status.Payment = proto.OrderPaymentStatus_ORDER_PAYMENT_STATUS_VOIDED
+			}
 		}
 	}()
 
-	order := fulfilOrder(ctx, input.Name, input.Items)
+	// The customer may have cancelled while we were taking payment.
+	if o.cancelled.IsReady() {
+		err = o.cancelled.Get(ctx, nil)
+		return err
+	}
+
+	order := o.fulfilOrder(ctx, o.Status.Items)
 	timer := fulfilmentTimer(ctx)
 
 	s := workflow.NewSelector(ctx)
@@ -146,16 +487,302 @@ func Order(ctx workflow.Context, input *proto.OrderInput) (*proto.OrderResult, e
 	})
 	s.AddFuture(timer, func(f workflow.Future) {
 		err = f.Get(ctx, nil)
+		o.Status.FulfilmentExpired = true
 	})
 
 	s.Select(ctx)
+	o.fulfilled = true
+
+	// Let any modification in progress settle its payment before we void or capture.
+	_ = workflow.Await(ctx, func() bool { return o.modifying == 0 })
+
 	if err != nil {
-		return &proto.OrderResult{}, err
+		return err
 	}
 
-	if input.Email != "" {
-		_ = addLoyaltyPoints(ctx, input)
+	if len(o.Status.Items) > 0 && o.Status.Refunded == o.Status.Total {
+		err = ErrOrderFailed
+		return err
 	}
 
-	return &proto.OrderResult{}, nil
+	// Only take the customer's money once every item has been made, and only for those which were.
+	err = o.settle(ctx)
+	if err != nil {
+		return err
+	}
+	o.Status.Payment = proto.OrderPaymentStatus_ORDER_PAYMENT_STATUS_PAID
+
+	if input.Email == "" {
+		o.Status.Loyalty = proto.OrderLoyaltyStatus_ORDER_LOYALTY_STATUS_SKIPPED
+		return nil
+	}
+
+	points, loyaltyErr := addLoyaltyPoints(ctx, input.Email, workflow.GetInfo(ctx).WorkflowExecution.ID, o.Status.Items, o.Status.Refunds, o.Status.Total-o.Status.Refunded)
+	if loyaltyErr != nil {
+		// The order itself succeeded, so a failed credit is reported in the status for the points
+		// to be adjusted by hand.
+		workflow.GetLogger(ctx).Error("failed to credit loyalty points", "email", input.Email, "error", loyaltyErr)
+		o.Status.Loyalty = proto.OrderLoyaltyStatus_ORDER_LOYALTY_STATUS_FAILED
+		o.Status.LoyaltyError = loyaltyErr.Error()
+		return nil
+	}
+	o.Status.Loyalty = proto.OrderLoyaltyStatus_ORDER_LOYALTY_STATUS_CREDITED
+	o.Status.LoyaltyPoints = points
+
+	return nil
+}
+
+func (o *OrderWorkflow) validateCancel(ctx workflow.Context, input *proto.OrderCancelInput) error {
+	if !o.Status.Open || o.Status.Cancelled || o.fulfilled {
+		return fmt.Errorf("order is no longer open")
+	}
+	if o.modifying > 0 {
+		return fmt.Errorf("order is being modified")
+	}
+	if o.hasCompletedItems() {
+		return fmt.Errorf("order has items which have already been completed")
+	}
+
+	return nil
+}
+
+func (o *OrderWorkflow) handleCancel(ctx workflow.Context, input *proto.OrderCancelInput) (*proto.OrderStatus, error) {
+	o.Status.Cancelled = true
+	o.cancel.Set(nil, ErrOrderCancelled)
+
+	return o.Status, nil
+}
+
+func (o *OrderWorkflow) validateModify(ctx workflow.Context, input *proto.OrderModifyInput) error {
+	if !o.Status.Open || o.Status.Cancelled || o.stationCtx == nil || o.fulfilled {
+		return fmt.Errorf("order is not being fulfilled")
+	}
+	if o.modifying > 0 {
+		return fmt.Errorf("order is already being modified")
+	}
+	if len(input.Add) == 0 && len(input.Remove) == 0 {
+		return fmt.Errorf("no changes requested")
+	}
+	if orderTotal(input.Add) > orderTotal(input.Remove) && o.cardTender() == nil {
+		return fmt.Errorf("added items can only be paid for by card")
+	}
+
+	for _, li := range input.Add {
+		if o.itemStation(li) == "" {
+			return fmt.Errorf("%w: %s", ErrNoStation, li.Name)
+		}
+		if li.Count == 0 || li.Count > MaxItemCount {
+			return fmt.Errorf("invalid count for item: %s", li.Name)
+		}
+	}
+	if uint64(o.Status.Total)+orderTotal(input.Add) > math.MaxUint32 {
+		return ErrOrderTotalTooLarge
+	}
+
+	for _, li := range input.Remove {
+		var ordered uint32
+		for _, v := range o.Status.Items {
+			if sameItem(v, li) {
+				ordered += v.Count
+			}
+		}
+		if li.Count == 0 || li.Count > ordered {
+			return fmt.Errorf("cannot remove %d of item: %s", li.Count, li.Name)
+		}
+		if li.Count > o.pendingItems(li) {
+			return fmt.Errorf("item has already been started: %s", li.Name)
+		}
+	}
+
+	return nil
+}
+
+func (o *OrderWorkflow) handleModify(ctx workflow.Context, input *proto.OrderModifyInput) (*proto.OrderStatus, error) {
+	o.modifying++
+	defer func() { o.modifying-- }()
+
+	// Removed items are simply not captured, so only an increase needs a further authorization.
+	total := uint32(uint64(o.Status.Total) + orderTotal(input.Add) - orderTotal(input.Remove))
+
+	var card *orderTender
+	var auth *orderPayment
+	if held := o.held(); total > held {
+		card = o.cardTender()
+		p, err := o.authorize(ctx, card, total-held)
+		if err != nil {
+			return nil, err
+		}
+		auth = p
+	}
+
+	stations, addByStation := o.groupItemsByStation(input.Add)
+	removeStations, removeByStation := o.groupItemsByStation(input.Remove)
+	for _, station := range removeStations {
+		if _, ok := addByStation[station]; !ok {
+			stations = append(stations, station)
+		}
+	}
+
+	for _, station := range stations {
+		if err := o.updateStation(ctx, station, addByStation[station], removeByStation[station]); err != nil {
+			if auth != nil && o.void(ctx, auth) == nil {
+				card.Status.Refunded += auth.amount
+			}
+			return nil, err
+		}
+	}
+
+	o.addItems(input.Add)
+	o.removeItems(input.Remove)
+	o.Status.Total = uint32(orderTotal(o.Status.Items))
+
+	return o.Status, nil
+}
+
+func (o *OrderWorkflow) addItems(items []*proto.OrderLineItem) {
+	for _, li := range items {
+		found := false
+		for _, v := range o.Status.Items {
+			if sameItem(v, li) {
+				v.Count += li.Count
+				found = true
+				break
+			}
+		}
+		if !found {
+			o.Status.Items = append(o.Status.Items, &proto.OrderLineItem{Type: li.Type, Name: li.Name, Price: li.Price, Count: li.Count, Modifiers: li.Modifiers, Station: o.itemStation(li)})
+		}
+	}
+}
+
+func (o *OrderWorkflow) removeItems(items []*proto.OrderLineItem) {
+	for _, li := range items {
+		for _, v := range o.Status.Items {
+			if sameItem(v, li) {
+				v.Count -= li.Count
+				break
+			}
+		}
+	}
+
+	var remaining []*proto.OrderLineItem
+	for _, v := range o.Status.Items {
+		if v.Count > 0 {
+			remaining = append(remaining, v)
+		}
+	}
+	o.Status.Items = remaining
+}
+
+// pendingItems counts the items matching the line item which the station has not yet started.
+func (o *OrderWorkflow) pendingItems(li *proto.OrderLineItem) uint32 {
+	var count uint32
+
+	modifiers := modifierNames(li.Modifiers)
+
+	if status, ok := o.Status.Stations[o.itemStation(li)]; ok {
+		for _, v := range status.Items {
+			if v.Name == li.Name && sameModifiers(v.Modifiers, modifiers) && v.Status == proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_PENDING {
+				count++
+			}
+		}
+	}
+
+	return count
+}
+
+func (o *OrderWorkflow) hasCompletedItems() bool {
+	for _, status := range o.Status.Stations {
+		for _, v := range status.Items {
+			if v.Status == proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// updateRefunds records the order items matching station items which have failed. They are
+// excluded from the amount captured.
+func (o *OrderWorkflow) updateRefunds() {
+	var refunds []*proto.OrderLineItem
+
+	refund := func(station string, name string, modifiers []string) {
+		for _, li := range o.Status.Items {
+			if o.itemStation(li) != station || li.Name != name || !sameModifiers(modifierNames(li.Modifiers), modifiers) {
+				continue
+			}
+			for _, r := range refunds {
+				if sameItem(r, li) {
+					r.Count++
+					return
+				}
+			}
+			refunds = append(refunds, &proto.OrderLineItem{Type: li.Type, Name: li.Name, Price: li.Price, Count: 1, Modifiers: li.Modifiers})
+			return
+		}
+	}
+
+	// Stations are visited in a fixed order so that the refunds are the same on replay.
+	var stations []string
+	for station := range o.Status.Stations {
+		stations = append(stations, station)
+	}
+	sort.Strings(stations)
+
+	for _, station := range stations {
+		for _, v := range o.Status.Stations[station].Items {
+			if v.Status == proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_FAILED {
+				refund(station, v.Name, v.Modifiers)
+			}
+		}
+	}
+
+	o.Status.Refunds = refunds
+	o.Status.Refunded = uint32(orderTotal(refunds))
+}
+
+// watchStations keeps the order status up to date with the progress reported by station workflows.
+func (o *OrderWorkflow) watchStations(ctx workflow.Context) {
+	ch := workflow.GetSignalChannel(ctx, proto.OrderStationStatusSignal)
+
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		for {
+			var status proto.StationOrderStatus
+			ch.Receive(ctx, &status)
+			if o.Status.CustomerForgotten {
+				status.Name = ""
+			}
+			if o.Status.Stations == nil {
+				o.Status.Stations = make(map[string]*proto.StationOrderStatus)
+			}
+			o.Status.Stations[status.Station] = &status
+			o.updateRefunds()
+		}
+	})
+}
+
+// watchCustomer removes the customer's details from the order if they ask to be forgotten. The order
+// carries on without them, but no longer credits loyalty points, and any points it holds are
+// forfeited along with the customer's account.
+func (o *OrderWorkflow) watchCustomer(ctx workflow.Context, input *proto.OrderInput) {
+	ch := workflow.GetSignalChannel(ctx, proto.OrderCustomerForgottenSignal)
+
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		ch.Receive(ctx, nil)
+
+		input.Name = ""
+		input.Email = ""
+		o.Status.Name = ""
+		o.Status.CustomerForgotten = true
+		for _, status := range o.Status.Stations {
+			status.Name = ""
+		}
+		for _, t := range o.tenders {
+			if t.Type == proto.TenderType_TENDER_TYPE_LOYALTY_POINTS {
+				t.Token = ""
+			}
+		}
+	})
 }
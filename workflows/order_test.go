@@ -3,111 +3,65 @@ package workflows_test
 import (
 	"context"
 	"fmt"
+	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	act "github.com/temporalio/temporal-cafe/activities"
 	"github.com/temporalio/temporal-cafe/proto"
 	"github.com/temporalio/temporal-cafe/workflows"
-	"go.temporal.io/sdk/activity"
 	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/testsuite"
 	"go.temporal.io/sdk/workflow"
 )
 
-var activities *act.Activities
+var activities = &act.Activities{Payments: act.NewPaymentSimulator(act.PaymentSimulatorOptions{})}
 
 func TestOrderWorkflow(t *testing.T) {
 	s := testsuite.WorkflowTestSuite{}
 	env := s.NewTestWorkflowEnvironment()
 
 	env.RegisterWorkflow(workflows.Order)
-	env.RegisterActivity(activities.ProcessPayment)
-	env.RegisterActivity(activities.ProcessPaymentRefund)
-	env.RegisterWorkflow(workflows.KitchenOrder)
-	env.RegisterWorkflow(workflows.BaristaOrder)
+	registerPayments(env)
+	registerStationOrders(env)
+	admitStationOrders(env)
 	env.RegisterActivity(activities.AddLoyaltyPoints)
 
 	input := &proto.OrderInput{
 		Email:        "test@example.com",
 		PaymentToken: "x",
 		Items: []*proto.OrderLineItem{
-			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Count: 1},
-			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "latte", Count: 2},
-			{Type: proto.ProductType_PRODUCT_TYPE_FOOD, Name: "bagel", Count: 2},
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Price: 300, Count: 1},
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "latte", Price: 350, Count: 2},
+			{Type: proto.ProductType_PRODUCT_TYPE_FOOD, Name: "bagel", Price: 500, Count: 2},
 		},
 	}
 
 	env.SetOnChildWorkflowStartedListener(func(workflowInfo *workflow.Info, ctx workflow.Context, args converter.EncodedValues) {
 		wid := workflowInfo.WorkflowExecution.ID
 
-		if workflowInfo.WorkflowType.Name == "BaristaOrder" {
-			env.SignalWorkflowByID(
-				wid,
-				proto.BaristaOrderItemStatusSignal,
-				proto.BaristaOrderItemStatusUpdate{
-					Line:   1,
-					Status: proto.BaristaOrderItemStatus_BARISTA_ORDER_ITEM_STATUS_COMPLETED,
-				},
-			)
-			env.SignalWorkflowByID(
-				wid,
-				proto.BaristaOrderItemStatusSignal,
-				proto.BaristaOrderItemStatusUpdate{
-					Line:   2,
-					Status: proto.BaristaOrderItemStatus_BARISTA_ORDER_ITEM_STATUS_COMPLETED,
-				},
-			)
-			env.SignalWorkflowByID(
-				wid,
-				proto.BaristaOrderItemStatusSignal,
-				proto.BaristaOrderItemStatusUpdate{
-					Line:   3,
-					Status: proto.BaristaOrderItemStatus_BARISTA_ORDER_ITEM_STATUS_COMPLETED,
-				},
-			)
-		}
-
-		if workflowInfo.WorkflowType.Name == "KitchenOrder" {
-			env.SignalWorkflowByID(
-				wid,
-				proto.KitchenOrderItemStatusSignal,
-				proto.KitchenOrderItemStatusUpdate{
-					Line:   1,
-					Status: proto.KitchenOrderItemStatus_KITCHEN_ORDER_ITEM_STATUS_COMPLETED,
-				},
-			)
-			env.SignalWorkflowByID(
-				wid,
-				proto.KitchenOrderItemStatusSignal,
-				proto.KitchenOrderItemStatusUpdate{
-					Line:   2,
-					Status: proto.KitchenOrderItemStatus_KITCHEN_ORDER_ITEM_STATUS_COMPLETED,
-				},
-			)
-		}
-	})
-
-	env.OnActivity(activities.ProcessPayment, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.ProcessPaymentInput) (*proto.ProcessPaymentResult, error) {
-		return &proto.ProcessPaymentResult{}, nil
-	})
+		if childStation(args) == workflows.StationBarista {
+			setItemStatus(env, wid, 1, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED)
+			setItemStatus(env, wid, 2, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED)
+			setItemStatus(env, wid, 3, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED)
+		}
 
-	env.OnActivity(activities.ProcessPaymentRefund, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.ProcessPaymentRefundInput) (*proto.ProcessPaymentRefundResult, error) {
-		return &proto.ProcessPaymentRefundResult{}, nil
+		if childStation(args) == workflows.StationKitchen {
+			setItemStatus(env, wid, 1, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED)
+			setItemStatus(env, wid, 2, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED)
+		}
 	})
 
-	env.OnActivity(activities.AddLoyaltyPoints, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.AddLoyaltyPointsInput) (*proto.AddLoyaltyPointsResult, error) {
-		return &proto.AddLoyaltyPointsResult{}, nil
-	})
+	env.OnActivity(activities.AddLoyaltyPoints, mock.Anything, mock.Anything).Return(&proto.AddLoyaltyPointsResult{Points: 5}, nil)
 
-	var activityCalls []string
-	env.SetOnActivityStartedListener(func(activityInfo *activity.Info, ctx context.Context, args converter.EncodedValues) {
-		activityCalls = append(activityCalls, activityInfo.ActivityType.Name)
-	})
+	activityCalls := orderActivityCalls(env)
 
 	expectedCalls := []string{
-		"ProcessPayment",
+		"AuthorizePayment",
+		"CapturePayment",
 		"AddLoyaltyPoints",
 	}
 
@@ -119,7 +73,22 @@ func TestOrderWorkflow(t *testing.T) {
 	err := env.GetWorkflowResult(&result)
 	assert.NoError(t, err)
 
-	assert.Equal(t, expectedCalls, activityCalls)
+	assert.Equal(t, expectedCalls, activityCalls())
+
+	v, err := env.QueryWorkflow(proto.OrderStatusQuery)
+	assert.NoError(t, err)
+	var status proto.OrderStatus
+	err = v.Get(&status)
+	assert.NoError(t, err)
+
+	assert.False(t, status.Open)
+	assert.Equal(t, proto.OrderPaymentStatus_ORDER_PAYMENT_STATUS_PAID, status.Payment)
+	assert.False(t, status.Stations[workflows.StationKitchen].Open)
+	assert.Len(t, status.Stations[workflows.StationKitchen].Items, 2)
+	assert.False(t, status.Stations[workflows.StationBarista].Open)
+	assert.Len(t, status.Stations[workflows.StationBarista].Items, 3)
+	assert.Equal(t, proto.OrderLoyaltyStatus_ORDER_LOYALTY_STATUS_CREDITED, status.Loyalty)
+	assert.Equal(t, uint32(5), status.LoyaltyPoints)
 }
 
 func TestOrderWorkflowFulfilmentDeadline(t *testing.T) {
@@ -127,51 +96,32 @@ func TestOrderWorkflowFulfilmentDeadline(t *testing.T) {
 	env := s.NewTestWorkflowEnvironment()
 
 	env.RegisterWorkflow(workflows.Order)
-	env.RegisterActivity(activities.ProcessPayment)
-	env.RegisterActivity(activities.ProcessPaymentRefund)
-	env.RegisterWorkflow(workflows.KitchenOrder)
-	env.RegisterWorkflow(workflows.BaristaOrder)
+	registerPayments(env)
+	registerStationOrders(env)
+	admitStationOrders(env)
 
 	input := &proto.OrderInput{
 		Email:        "test@example.com",
 		PaymentToken: "x",
 		Items: []*proto.OrderLineItem{
-			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Count: 1},
-			{Type: proto.ProductType_PRODUCT_TYPE_FOOD, Name: "bagel", Count: 1},
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Price: 300, Count: 1},
+			{Type: proto.ProductType_PRODUCT_TYPE_FOOD, Name: "bagel", Price: 500, Count: 1},
 		},
 	}
 
 	env.SetOnChildWorkflowStartedListener(func(workflowInfo *workflow.Info, ctx workflow.Context, args converter.EncodedValues) {
 		wid := workflowInfo.WorkflowExecution.ID
 
-		if workflowInfo.WorkflowType.Name == "BaristaOrder" {
-			env.SignalWorkflowByID(
-				wid,
-				proto.BaristaOrderItemStatusSignal,
-				proto.BaristaOrderItemStatusUpdate{
-					Line:   1,
-					Status: proto.BaristaOrderItemStatus_BARISTA_ORDER_ITEM_STATUS_COMPLETED,
-				},
-			)
+		if childStation(args) == workflows.StationBarista {
+			setItemStatus(env, wid, 1, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED)
 		}
 	})
 
-	env.OnActivity(activities.ProcessPayment, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.ProcessPaymentInput) (*proto.ProcessPaymentResult, error) {
-		return &proto.ProcessPaymentResult{}, nil
-	})
-
-	env.OnActivity(activities.ProcessPaymentRefund, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.ProcessPaymentRefundInput) (*proto.ProcessPaymentRefundResult, error) {
-		return &proto.ProcessPaymentRefundResult{}, nil
-	})
-
-	var activityCalls []string
-	env.SetOnActivityStartedListener(func(activityInfo *activity.Info, ctx context.Context, args converter.EncodedValues) {
-		activityCalls = append(activityCalls, activityInfo.ActivityType.Name)
-	})
+	activityCalls := orderActivityCalls(env)
 
 	expectedCalls := []string{
-		"ProcessPayment",
-		"ProcessPaymentRefund",
+		"AuthorizePayment",
+		"VoidPayment",
 	}
 
 	env.ExecuteWorkflow(workflows.Order, input)
@@ -182,41 +132,49 @@ func TestOrderWorkflowFulfilmentDeadline(t *testing.T) {
 	err := env.GetWorkflowResult(&result)
 	assert.Error(t, fmt.Errorf("order not fulfilled within window"), err)
 
-	assert.Equal(t, expectedCalls, activityCalls)
+	assert.Equal(t, expectedCalls, activityCalls())
+
+	v, err := env.QueryWorkflow(proto.OrderStatusQuery)
+	assert.NoError(t, err)
+	var status proto.OrderStatus
+	err = v.Get(&status)
+	assert.NoError(t, err)
+
+	assert.False(t, status.Open)
+	assert.True(t, status.FulfilmentExpired)
+	assert.Equal(t, proto.OrderPaymentStatus_ORDER_PAYMENT_STATUS_VOIDED, status.Payment)
+	assert.Equal(t, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED, status.Stations[workflows.StationBarista].Items[0].Status)
+	assert.Equal(t, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_PENDING, status.Stations[workflows.StationKitchen].Items[0].Status)
 }
 
-func TestOrderWorkflowRefund(t *testing.T) {
+func TestOrderWorkflowVoid(t *testing.T) {
 	s := testsuite.WorkflowTestSuite{}
 	env := s.NewTestWorkflowEnvironment()
 
 	env.RegisterWorkflow(workflows.Order)
-	env.RegisterActivity(activities.ProcessPayment)
-	env.RegisterActivity(activities.ProcessPaymentRefund)
+	registerPayments(env)
 
 	input := &proto.OrderInput{
 		PaymentToken: "x",
 		Items: []*proto.OrderLineItem{
-			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Count: 1},
-			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "latte", Count: 2},
-			{Type: proto.ProductType_PRODUCT_TYPE_FOOD, Name: "bagel", Count: 2},
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Price: 300, Count: 1},
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "latte", Price: 350, Count: 2},
+			{Type: proto.ProductType_PRODUCT_TYPE_FOOD, Name: "bagel", Price: 500, Count: 2},
 		},
 	}
 
-	env.OnWorkflow(workflows.BaristaOrder, mock.Anything, mock.Anything).Return(func(ctx workflow.Context, input *proto.BaristaOrderInput) (*proto.BaristaOrderResult, error) {
-		return &proto.BaristaOrderResult{}, fmt.Errorf("failed")
-	})
-	env.OnWorkflow(workflows.KitchenOrder, mock.Anything, mock.Anything).Return(func(ctx workflow.Context, input *proto.KitchenOrderInput) (*proto.KitchenOrderResult, error) {
-		return &proto.KitchenOrderResult{}, nil
+	env.OnWorkflow(workflows.StationOrder, mock.Anything, mock.Anything).Return(func(ctx workflow.Context, input *proto.StationOrderInput) (*proto.StationOrderResult, error) {
+		if input.Station == workflows.StationBarista {
+			return &proto.StationOrderResult{}, fmt.Errorf("failed")
+		}
+		return &proto.StationOrderResult{}, nil
 	})
 
-	var activityCalls []string
-	env.SetOnActivityStartedListener(func(activityInfo *activity.Info, ctx context.Context, args converter.EncodedValues) {
-		activityCalls = append(activityCalls, activityInfo.ActivityType.Name)
-	})
+	activityCalls := orderActivityCalls(env)
 
 	expectedCalls := []string{
-		"ProcessPayment",
-		"ProcessPaymentRefund",
+		"AuthorizePayment",
+		"VoidPayment",
 	}
 
 	env.ExecuteWorkflow(workflows.Order, input)
@@ -227,5 +185,1174 @@ func TestOrderWorkflowRefund(t *testing.T) {
 	err := env.GetWorkflowResult(&result)
 	assert.Error(t, err)
 
-	assert.Equal(t, expectedCalls, activityCalls)
+	assert.Equal(t, expectedCalls, activityCalls())
+}
+
+func TestOrderWorkflowPaymentDeclined(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Order)
+	registerPayments(env)
+
+	input := &proto.OrderInput{
+		PaymentToken: act.SimulatorDeclineToken,
+		Items: []*proto.OrderLineItem{
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Price: 300, Count: 1},
+		},
+	}
+
+	activityCalls := orderActivityCalls(env)
+
+	env.ExecuteWorkflow(workflows.Order, input)
+	assert.True(t, env.IsWorkflowCompleted())
+
+	err := env.GetWorkflowError()
+	assert.ErrorContains(t, err, workflows.ErrPaymentDeclined.Error())
+
+	// Declines are not retried.
+	assert.Equal(t, []string{"AuthorizePayment"}, activityCalls())
+
+	v, err := env.QueryWorkflow(proto.OrderStatusQuery)
+	assert.NoError(t, err)
+	var status proto.OrderStatus
+	assert.NoError(t, v.Get(&status))
+
+	assert.Equal(t, proto.OrderPaymentStatus_ORDER_PAYMENT_STATUS_FAILED, status.Payment)
+	assert.Equal(t, workflows.ErrPaymentDeclined.Error(), status.Error)
+}
+
+func TestOrderWorkflowPaymentRetried(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	gateway := &act.Activities{Payments: act.NewPaymentSimulator(act.PaymentSimulatorOptions{TransientFailures: 2})}
+
+	env.RegisterWorkflow(workflows.Order)
+	env.RegisterActivity(gateway.AuthorizePayment)
+	env.RegisterActivity(gateway.CapturePayment)
+	env.RegisterActivity(gateway.VoidPayment)
+
+	input := &proto.OrderInput{
+		PaymentToken: act.SimulatorTransientToken,
+		Items: []*proto.OrderLineItem{
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Price: 300, Count: 1},
+		},
+	}
+
+	env.OnWorkflow(workflows.StationOrder, mock.Anything, mock.Anything).Return(func(ctx workflow.Context, input *proto.StationOrderInput) (*proto.StationOrderResult, error) {
+		return &proto.StationOrderResult{}, nil
+	})
+
+	activityCalls := orderActivityCalls(env)
+
+	env.ExecuteWorkflow(workflows.Order, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+
+	assert.Equal(t, []string{"AuthorizePayment", "AuthorizePayment", "AuthorizePayment", "CapturePayment"}, activityCalls())
+}
+
+func TestOrderWorkflowCancel(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Order)
+	registerPayments(env)
+	registerStationOrders(env)
+	admitStationOrders(env)
+
+	input := &proto.OrderInput{
+		PaymentToken: "x",
+		Items: []*proto.OrderLineItem{
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Price: 300, Count: 1},
+			{Type: proto.ProductType_PRODUCT_TYPE_FOOD, Name: "bagel", Price: 500, Count: 1},
+		},
+	}
+
+	env.SetOnChildWorkflowStartedListener(func(workflowInfo *workflow.Info, ctx workflow.Context, args converter.EncodedValues) {
+		wid := workflowInfo.WorkflowExecution.ID
+
+		if childStation(args) == workflows.StationBarista {
+			setItemStatus(env, wid, 1, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_STARTED)
+		}
+	})
+
+	activityCalls := orderActivityCalls(env)
+
+	expectedCalls := []string{
+		"AuthorizePayment",
+		"VoidPayment",
+	}
+
+	env.RegisterDelayedCallback(func() {
+		env.UpdateWorkflow(proto.OrderCancelUpdate, "cancel", &updateCallback{
+			reject: func(err error) {
+				assert.Fail(t, "unexpected rejection", err)
+			},
+			complete: func(result interface{}, err error) {
+				assert.NoError(t, err)
+				assert.True(t, result.(*proto.OrderStatus).Cancelled)
+			},
+		}, &proto.OrderCancelInput{})
+	}, time.Minute)
+
+	env.ExecuteWorkflow(workflows.Order, input)
+	assert.True(t, env.IsWorkflowCompleted())
+
+	err := env.GetWorkflowError()
+	assert.ErrorContains(t, err, workflows.ErrOrderCancelled.Error())
+
+	assert.Equal(t, expectedCalls, activityCalls())
+
+	v, err := env.QueryWorkflow(proto.OrderStatusQuery)
+	assert.NoError(t, err)
+	var status proto.OrderStatus
+	err = v.Get(&status)
+	assert.NoError(t, err)
+
+	assert.True(t, status.Cancelled)
+	assert.Equal(t, proto.OrderPaymentStatus_ORDER_PAYMENT_STATUS_VOIDED, status.Payment)
+}
+
+func TestOrderWorkflowCancelRejected(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Order)
+	registerPayments(env)
+	registerStationOrders(env)
+	admitStationOrders(env)
+
+	input := &proto.OrderInput{
+		PaymentToken: "x",
+		Items: []*proto.OrderLineItem{
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Price: 300, Count: 2},
+		},
+	}
+
+	env.SetOnChildWorkflowStartedListener(func(workflowInfo *workflow.Info, ctx workflow.Context, args converter.EncodedValues) {
+		setItemStatus(env, workflowInfo.WorkflowExecution.ID, 1, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED)
+	})
+
+	var rejected error
+	env.RegisterDelayedCallback(func() {
+		env.UpdateWorkflow(proto.OrderCancelUpdate, "cancel", &updateCallback{
+			reject: func(err error) {
+				rejected = err
+			},
+			complete: func(result interface{}, err error) {
+				assert.Fail(t, "unexpected completion")
+			},
+		}, &proto.OrderCancelInput{})
+	}, time.Minute)
+
+	env.ExecuteWorkflow(workflows.Order, input)
+	assert.True(t, env.IsWorkflowCompleted())
+
+	assert.Error(t, rejected)
+
+	v, err := env.QueryWorkflow(proto.OrderStatusQuery)
+	assert.NoError(t, err)
+	var status proto.OrderStatus
+	err = v.Get(&status)
+	assert.NoError(t, err)
+
+	assert.False(t, status.Cancelled)
+	assert.True(t, status.FulfilmentExpired)
+}
+
+func TestOrderWorkflowModifyAdd(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Order)
+	registerPayments(env)
+	registerStationOrders(env)
+	admitStationOrders(env)
+
+	input := &proto.OrderInput{
+		PaymentToken: "x",
+		Items: []*proto.OrderLineItem{
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Price: 300, Count: 1},
+		},
+	}
+
+	var baristaID string
+	env.SetOnChildWorkflowStartedListener(func(workflowInfo *workflow.Info, ctx workflow.Context, args converter.EncodedValues) {
+		wid := workflowInfo.WorkflowExecution.ID
+
+		if childStation(args) == workflows.StationBarista {
+			baristaID = wid
+			setItemStatus(env, wid, 1, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_STARTED)
+		}
+
+		if childStation(args) == workflows.StationKitchen {
+			setItemStatus(env, wid, 1, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED)
+		}
+	})
+
+	var authorizations []uint32
+	var references []string
+	env.OnActivity(activities.AuthorizePayment, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.AuthorizePaymentInput) (*proto.AuthorizePaymentResult, error) {
+		authorizations = append(authorizations, input.Amount)
+		references = append(references, input.Reference)
+		return &proto.AuthorizePaymentResult{}, nil
+	})
+
+	var captures []uint32
+	env.OnActivity(activities.CapturePayment, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.CapturePaymentInput) (*proto.CapturePaymentResult, error) {
+		captures = append(captures, input.Amount)
+		return &proto.CapturePaymentResult{}, nil
+	})
+
+	env.RegisterDelayedCallback(func() {
+		env.UpdateWorkflow(proto.OrderModifyUpdate, "modify", &updateCallback{
+			reject: func(err error) {
+				assert.Fail(t, "unexpected rejection", err)
+			},
+			complete: func(result interface{}, err error) {
+				assert.NoError(t, err)
+			},
+		}, &proto.OrderModifyInput{
+			Add: []*proto.OrderLineItem{
+				{Type: proto.ProductType_PRODUCT_TYPE_FOOD, Name: "bagel", Price: 500, Count: 1},
+			},
+		})
+	}, time.Minute)
+
+	env.RegisterDelayedCallback(func() {
+		setItemStatus(env, baristaID, 1, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED)
+	}, 2*time.Minute)
+
+	env.ExecuteWorkflow(workflows.Order, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+
+	assert.Equal(t, []uint32{300, 500}, authorizations)
+	// Each authorization has its own reference, which its retries share.
+	assert.Equal(t, []string{"default-test-workflow-id/1/1", "default-test-workflow-id/1/2"}, references)
+	assert.Equal(t, []uint32{300, 500}, captures)
+
+	v, err := env.QueryWorkflow(proto.OrderStatusQuery)
+	assert.NoError(t, err)
+	var status proto.OrderStatus
+	err = v.Get(&status)
+	assert.NoError(t, err)
+
+	assert.Equal(t, uint32(800), status.Total)
+	assert.Len(t, status.Items, 2)
+	assert.Equal(t, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED, status.Stations[workflows.StationKitchen].Items[0].Status)
+}
+
+func TestOrderWorkflowModifyRemove(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Order)
+	registerPayments(env)
+	registerStationOrders(env)
+	admitStationOrders(env)
+
+	input := &proto.OrderInput{
+		PaymentToken: "x",
+		Items: []*proto.OrderLineItem{
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Price: 300, Count: 2},
+		},
+	}
+
+	var baristaID string
+	env.SetOnChildWorkflowStartedListener(func(workflowInfo *workflow.Info, ctx workflow.Context, args converter.EncodedValues) {
+		baristaID = workflowInfo.WorkflowExecution.ID
+		setItemStatus(env, baristaID, 1, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_STARTED)
+	})
+
+	var captures []uint32
+	env.OnActivity(activities.CapturePayment, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.CapturePaymentInput) (*proto.CapturePaymentResult, error) {
+		captures = append(captures, input.Amount)
+		return &proto.CapturePaymentResult{}, nil
+	})
+
+	var rejected error
+	env.RegisterDelayedCallback(func() {
+		// Both coffees can't be removed as one has already been started.
+		env.UpdateWorkflow(proto.OrderModifyUpdate, "remove-both", &updateCallback{
+			reject: func(err error) {
+				rejected = err
+			},
+		}, &proto.OrderModifyInput{
+			Remove: []*proto.OrderLineItem{
+				{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Price: 300, Count: 2},
+			},
+		})
+
+		env.UpdateWorkflow(proto.OrderModifyUpdate, "remove-one", &updateCallback{
+			reject: func(err error) {
+				assert.Fail(t, "unexpected rejection", err)
+			},
+			complete: func(result interface{}, err error) {
+				assert.NoError(t, err)
+			},
+		}, &proto.OrderModifyInput{
+			Remove: []*proto.OrderLineItem{
+				{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Price: 300, Count: 1},
+			},
+		})
+	}, time.Minute)
+
+	env.RegisterDelayedCallback(func() {
+		setItemStatus(env, baristaID, 1, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED)
+	}, 2*time.Minute)
+
+	env.ExecuteWorkflow(workflows.Order, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+
+	assert.Error(t, rejected)
+	// The removed coffee is never captured, so there is nothing to refund.
+	assert.Equal(t, []uint32{300}, captures)
+
+	v, err := env.QueryWorkflow(proto.OrderStatusQuery)
+	assert.NoError(t, err)
+	var status proto.OrderStatus
+	err = v.Get(&status)
+	assert.NoError(t, err)
+
+	assert.Equal(t, uint32(300), status.Total)
+	assert.Equal(t, uint32(0), status.Refunded)
+	assert.Equal(t, proto.OrderPaymentStatus_ORDER_PAYMENT_STATUS_PAID, status.Payment)
+	assert.Equal(t, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED, status.Stations[workflows.StationBarista].Items[0].Status)
+	assert.Equal(t, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_CANCELLED, status.Stations[workflows.StationBarista].Items[1].Status)
+}
+
+func TestOrderWorkflowModifyAfterFulfilment(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Order)
+	registerPayments(env)
+	registerStationOrders(env)
+	admitStationOrders(env)
+
+	input := &proto.OrderInput{
+		PaymentToken: "x",
+		Items: []*proto.OrderLineItem{
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Price: 300, Count: 1},
+		},
+	}
+
+	env.SetOnChildWorkflowStartedListener(func(workflowInfo *workflow.Info, ctx workflow.Context, args converter.EncodedValues) {
+		setItemStatus(env, workflowInfo.WorkflowExecution.ID, 1, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED)
+	})
+
+	var authorizations []uint32
+	env.OnActivity(activities.AuthorizePayment, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.AuthorizePaymentInput) (*proto.AuthorizePaymentResult, error) {
+		authorizations = append(authorizations, input.Amount)
+		return &proto.AuthorizePaymentResult{}, nil
+	})
+
+	env.OnActivity(activities.CapturePayment, mock.Anything, mock.Anything).Return(&proto.CapturePaymentResult{}, nil).After(time.Minute)
+
+	// The stations have finished, but the order is still open while its payment is captured.
+	var rejected error
+	env.RegisterDelayedCallback(func() {
+		env.UpdateWorkflow(proto.OrderModifyUpdate, "modify", &updateCallback{
+			reject: func(err error) {
+				rejected = err
+			},
+			complete: func(interface{}, error) {
+				assert.Fail(t, "order modified after fulfilment")
+			},
+		}, &proto.OrderModifyInput{
+			Add: []*proto.OrderLineItem{
+				{Type: proto.ProductType_PRODUCT_TYPE_FOOD, Name: "bagel", Price: 500, Count: 1},
+			},
+		})
+	}, 30*time.Second)
+
+	env.ExecuteWorkflow(workflows.Order, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+
+	assert.ErrorContains(t, rejected, "order is not being fulfilled")
+	assert.Equal(t, []uint32{300}, authorizations)
+}
+
+func TestOrderWorkflowItemFailed(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Order)
+	registerPayments(env)
+	registerStationOrders(env)
+	admitStationOrders(env)
+	env.RegisterActivity(activities.AddLoyaltyPoints)
+
+	input := &proto.OrderInput{
+		Email:        "test@example.com",
+		PaymentToken: "x",
+		Items: []*proto.OrderLineItem{
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Price: 300, Count: 1},
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "latte", Price: 350, Count: 2},
+			{Type: proto.ProductType_PRODUCT_TYPE_FOOD, Name: "bagel", Price: 500, Count: 1},
+		},
+	}
+
+	env.SetOnChildWorkflowStartedListener(func(workflowInfo *workflow.Info, ctx workflow.Context, args converter.EncodedValues) {
+		wid := workflowInfo.WorkflowExecution.ID
+
+		if childStation(args) == workflows.StationBarista {
+			for line, status := range []proto.StationOrderItemStatus{
+				proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED,
+				proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_FAILED,
+				proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED,
+			} {
+				setItemStatus(env, wid, uint32(line+1), status)
+			}
+		}
+
+		if childStation(args) == workflows.StationKitchen {
+			setItemStatus(env, wid, 1, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED)
+		}
+	})
+
+	var captures []uint32
+	env.OnActivity(activities.CapturePayment, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.CapturePaymentInput) (*proto.CapturePaymentResult, error) {
+		captures = append(captures, input.Amount)
+		return &proto.CapturePaymentResult{}, nil
+	})
+
+	var credited *proto.AddLoyaltyPointsInput
+	env.OnActivity(activities.AddLoyaltyPoints, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.AddLoyaltyPointsInput) (*proto.AddLoyaltyPointsResult, error) {
+		credited = input
+		return &proto.AddLoyaltyPointsResult{Points: 3}, nil
+	})
+
+	env.ExecuteWorkflow(workflows.Order, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+
+	// Only the latte which couldn't be made is left uncaptured.
+	assert.Equal(t, []uint32{1150}, captures)
+
+	v, err := env.QueryWorkflow(proto.OrderStatusQuery)
+	assert.NoError(t, err)
+	var status proto.OrderStatus
+	err = v.Get(&status)
+	assert.NoError(t, err)
+
+	assert.Equal(t, proto.OrderPaymentStatus_ORDER_PAYMENT_STATUS_PAID, status.Payment)
+	assert.Equal(t, uint32(1500), status.Total)
+	assert.Equal(t, uint32(350), status.Refunded)
+	if assert.Len(t, status.Refunds, 1) {
+		assert.Equal(t, "latte", status.Refunds[0].Name)
+		assert.Equal(t, uint32(1), status.Refunds[0].Count)
+	}
+	// The customer isn't credited for the latte which couldn't be made.
+	if assert.NotNil(t, credited) && assert.Len(t, credited.Refunds, 1) {
+		assert.Equal(t, "latte", credited.Refunds[0].Name)
+		assert.Equal(t, uint32(1), credited.Refunds[0].Count)
+	}
+	assert.Equal(t, uint32(3), status.LoyaltyPoints)
+}
+
+func TestOrderWorkflowItemRemade(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Order)
+	registerPayments(env)
+	registerStationOrders(env)
+	admitStationOrders(env)
+
+	input := &proto.OrderInput{
+		PaymentToken: "x",
+		MaxRemakes:   1,
+		Items: []*proto.OrderLineItem{
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Price: 300, Count: 1},
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "latte", Price: 350, Count: 1},
+		},
+	}
+
+	env.SetOnChildWorkflowStartedListener(func(workflowInfo *workflow.Info, ctx workflow.Context, args converter.EncodedValues) {
+		wid := workflowInfo.WorkflowExecution.ID
+
+		// The latte is remade on line 3.
+		for _, update := range []*proto.StationOrderItemStatusInput{
+			{Line: 1, Status: proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED},
+			{Line: 2, Status: proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_FAILED, Reason: "spilled"},
+			{Line: 3, Status: proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED},
+		} {
+			env.SignalWorkflowByID(wid, workflows.StationOrderItemStatusTestSignal, update)
+		}
+	})
+
+	var captures []uint32
+	env.OnActivity(activities.CapturePayment, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.CapturePaymentInput) (*proto.CapturePaymentResult, error) {
+		captures = append(captures, input.Amount)
+		return &proto.CapturePaymentResult{}, nil
+	})
+
+	env.ExecuteWorkflow(workflows.Order, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+
+	// The remade latte is paid for.
+	assert.Equal(t, []uint32{650}, captures)
+
+	v, err := env.QueryWorkflow(proto.OrderStatusQuery)
+	assert.NoError(t, err)
+	var status proto.OrderStatus
+	assert.NoError(t, v.Get(&status))
+
+	assert.Equal(t, uint32(0), status.Refunded)
+	assert.Empty(t, status.Refunds)
+	assert.Len(t, status.Stations[workflows.StationBarista].Items, 3)
+}
+
+func TestOrderWorkflowAllItemsFailed(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Order)
+	registerPayments(env)
+	registerStationOrders(env)
+	admitStationOrders(env)
+
+	input := &proto.OrderInput{
+		PaymentToken: "x",
+		Items: []*proto.OrderLineItem{
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Price: 300, Count: 1},
+		},
+	}
+
+	env.SetOnChildWorkflowStartedListener(func(workflowInfo *workflow.Info, ctx workflow.Context, args converter.EncodedValues) {
+		setItemStatus(env, workflowInfo.WorkflowExecution.ID, 1, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_FAILED)
+	})
+
+	activityCalls := orderActivityCalls(env)
+
+	env.ExecuteWorkflow(workflows.Order, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.ErrorContains(t, env.GetWorkflowError(), workflows.ErrOrderFailed.Error())
+
+	assert.Equal(t, []string{"AuthorizePayment", "VoidPayment"}, activityCalls())
+
+	v, err := env.QueryWorkflow(proto.OrderStatusQuery)
+	assert.NoError(t, err)
+	var status proto.OrderStatus
+	err = v.Get(&status)
+	assert.NoError(t, err)
+
+	assert.Equal(t, proto.OrderPaymentStatus_ORDER_PAYMENT_STATUS_VOIDED, status.Payment)
+	assert.Equal(t, uint32(300), status.Refunded)
+}
+
+func TestOrderWorkflowStationRouting(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Order)
+	registerPayments(env)
+
+	input := &proto.OrderInput{
+		PaymentToken: "x",
+		Items: []*proto.OrderLineItem{
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "iced latte", Price: 400, Count: 1, Station: "bar"},
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "latte", Price: 350, Count: 1},
+		},
+	}
+
+	itemsByStation := make(map[string][]string)
+	env.OnWorkflow(workflows.StationOrder, mock.Anything, mock.Anything).Return(func(ctx workflow.Context, input *proto.StationOrderInput) (*proto.StationOrderResult, error) {
+		for _, li := range input.Items {
+			itemsByStation[input.Station] = append(itemsByStation[input.Station], li.Name)
+		}
+		return &proto.StationOrderResult{}, nil
+	})
+
+	env.ExecuteWorkflow(workflows.Order, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+
+	// Items without a station use the default routing.
+	assert.Equal(t, map[string][]string{
+		"bar":                    {"iced latte"},
+		workflows.StationBarista: {"latte"},
+	}, itemsByStation)
+}
+
+func TestOrderWorkflowNoStation(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Order)
+	registerPayments(env)
+	registerStationOrders(env)
+	admitStationOrders(env)
+
+	input := &proto.OrderInput{
+		PaymentToken: "x",
+		Items: []*proto.OrderLineItem{
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Price: 300, Count: 1},
+			{Type: proto.ProductType_PRODUCT_TYPE_UNKNOWN, Name: "mystery", Price: 100, Count: 1},
+		},
+	}
+
+	activityCalls := orderActivityCalls(env)
+
+	started := false
+	env.SetOnChildWorkflowStartedListener(func(workflowInfo *workflow.Info, ctx workflow.Context, args converter.EncodedValues) {
+		started = true
+	})
+
+	env.ExecuteWorkflow(workflows.Order, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.ErrorContains(t, env.GetWorkflowError(), workflows.ErrNoStation.Error())
+
+	// The order is rejected before the customer is charged or anything is made.
+	assert.Empty(t, activityCalls())
+	assert.False(t, started)
+}
+
+func TestOrderWorkflowTotalTooLarge(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Order)
+	registerPayments(env)
+	registerStationOrders(env)
+	admitStationOrders(env)
+
+	// Each line is within the limit, but in 32 bits the total would wrap around to a few dollars.
+	input := &proto.OrderInput{
+		PaymentToken: "x",
+		Items: []*proto.OrderLineItem{
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Price: math.MaxUint32 / 2, Count: 2},
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "latte", Price: 300, Count: 1},
+		},
+	}
+
+	activityCalls := orderActivityCalls(env)
+
+	started := false
+	env.SetOnChildWorkflowStartedListener(func(workflowInfo *workflow.Info, ctx workflow.Context, args converter.EncodedValues) {
+		started = true
+	})
+
+	env.ExecuteWorkflow(workflows.Order, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.ErrorContains(t, env.GetWorkflowError(), workflows.ErrOrderTotalTooLarge.Error())
+
+	assert.Empty(t, activityCalls())
+	assert.False(t, started)
+}
+
+func TestOrderWorkflowGiftCard(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Order)
+	env.RegisterActivity(activities.RedeemGiftCard)
+	env.RegisterActivity(activities.RefundGiftCard)
+	registerPayments(env)
+	registerStationOrders(env)
+	admitStationOrders(env)
+
+	input := &proto.OrderInput{
+		PaymentToken: "x",
+		GiftCard:     "1234",
+		Items: []*proto.OrderLineItem{
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Price: 300, Count: 1},
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "latte", Price: 350, Count: 2},
+		},
+	}
+
+	env.SetOnChildWorkflowStartedListener(func(workflowInfo *workflow.Info, ctx workflow.Context, args converter.EncodedValues) {
+		for line := uint32(1); line <= 3; line++ {
+			setItemStatus(env, workflowInfo.WorkflowExecution.ID, line, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED)
+		}
+	})
+
+	// The card only has enough left for some of the order.
+	env.OnActivity(activities.RedeemGiftCard, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.RedeemGiftCardInput) (*proto.RedeemGiftCardResult, error) {
+		return &proto.RedeemGiftCardResult{Amount: 700}, nil
+	})
+
+	var authorizations []uint32
+	env.OnActivity(activities.AuthorizePayment, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.AuthorizePaymentInput) (*proto.AuthorizePaymentResult, error) {
+		authorizations = append(authorizations, input.Amount)
+		return &proto.AuthorizePaymentResult{}, nil
+	})
+
+	var captures []uint32
+	env.OnActivity(activities.CapturePayment, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.CapturePaymentInput) (*proto.CapturePaymentResult, error) {
+		captures = append(captures, input.Amount)
+		return &proto.CapturePaymentResult{}, nil
+	})
+
+	activityCalls := orderActivityCalls(env)
+
+	env.ExecuteWorkflow(workflows.Order, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+
+	assert.Equal(t, []string{"RedeemGiftCard", "AuthorizePayment", "CapturePayment"}, activityCalls())
+	assert.Equal(t, []uint32{300}, authorizations)
+	assert.Equal(t, []uint32{300}, captures)
+
+	v, err := env.QueryWorkflow(proto.OrderStatusQuery)
+	assert.NoError(t, err)
+	var status proto.OrderStatus
+	err = v.Get(&status)
+	assert.NoError(t, err)
+
+	assert.Equal(t, proto.OrderPaymentStatus_ORDER_PAYMENT_STATUS_PAID, status.Payment)
+	if assert.Len(t, status.Tenders, 2) {
+		assert.Equal(t, uint32(700), status.Tenders[0].Amount)
+		assert.Equal(t, uint32(300), status.Tenders[1].Amount)
+	}
+}
+
+func TestOrderWorkflowGiftCardRefunded(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Order)
+	env.RegisterActivity(activities.RedeemGiftCard)
+	env.RegisterActivity(activities.RefundGiftCard)
+	registerPayments(env)
+
+	input := &proto.OrderInput{
+		PaymentToken: "x",
+		GiftCard:     "1234",
+		Items: []*proto.OrderLineItem{
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Price: 300, Count: 1},
+		},
+	}
+
+	env.OnWorkflow(workflows.StationOrder, mock.Anything, mock.Anything).Return(func(ctx workflow.Context, input *proto.StationOrderInput) (*proto.StationOrderResult, error) {
+		return &proto.StationOrderResult{}, fmt.Errorf("failed")
+	})
+
+	env.OnActivity(activities.RedeemGiftCard, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.RedeemGiftCardInput) (*proto.RedeemGiftCardResult, error) {
+		return &proto.RedeemGiftCardResult{Amount: input.Amount}, nil
+	})
+
+	var refunds []uint32
+	env.OnActivity(activities.RefundGiftCard, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.RefundGiftCardInput) (*proto.RefundGiftCardResult, error) {
+		refunds = append(refunds, input.Amount)
+		return &proto.RefundGiftCardResult{}, nil
+	})
+
+	activityCalls := orderActivityCalls(env)
+
+	env.ExecuteWorkflow(workflows.Order, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.Error(t, env.GetWorkflowError())
+
+	// The gift card covered the whole order so there was nothing to authorize.
+	assert.Equal(t, []string{"RedeemGiftCard", "RefundGiftCard"}, activityCalls())
+	assert.Equal(t, []uint32{300}, refunds)
+
+	v, err := env.QueryWorkflow(proto.OrderStatusQuery)
+	assert.NoError(t, err)
+	var status proto.OrderStatus
+	err = v.Get(&status)
+	assert.NoError(t, err)
+
+	assert.Equal(t, proto.OrderPaymentStatus_ORDER_PAYMENT_STATUS_VOIDED, status.Payment)
+	if assert.Len(t, status.Tenders, 2) {
+		assert.Equal(t, uint32(300), status.Tenders[0].Amount)
+		assert.Equal(t, uint32(300), status.Tenders[0].Refunded)
+	}
+}
+
+func TestOrderWorkflowGiftCardDeclined(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Order)
+	env.RegisterActivity(activities.RedeemGiftCard)
+	registerPayments(env)
+
+	input := &proto.OrderInput{
+		PaymentToken: "x",
+		GiftCard:     "1234",
+		Items: []*proto.OrderLineItem{
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Price: 300, Count: 1},
+		},
+	}
+
+	env.OnActivity(activities.RedeemGiftCard, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.RedeemGiftCardInput) (*proto.RedeemGiftCardResult, error) {
+		return nil, temporal.NewNonRetryableApplicationError("gift card has expired", act.GiftCardDeclinedError, nil)
+	})
+
+	activityCalls := orderActivityCalls(env)
+
+	env.ExecuteWorkflow(workflows.Order, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.ErrorContains(t, env.GetWorkflowError(), workflows.ErrGiftCardDeclined.Error())
+
+	assert.Equal(t, []string{"RedeemGiftCard"}, activityCalls())
+
+	v, err := env.QueryWorkflow(proto.OrderStatusQuery)
+	assert.NoError(t, err)
+	var status proto.OrderStatus
+	err = v.Get(&status)
+	assert.NoError(t, err)
+
+	assert.Equal(t, proto.OrderPaymentStatus_ORDER_PAYMENT_STATUS_FAILED, status.Payment)
+}
+
+func TestOrderWorkflowSplitTender(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Order)
+	registerPayments(env)
+	registerStationOrders(env)
+	admitStationOrders(env)
+
+	input := &proto.OrderInput{
+		Tenders: []*proto.Tender{
+			{Type: proto.TenderType_TENDER_TYPE_CARD, Token: "a", Amount: 500},
+			{Type: proto.TenderType_TENDER_TYPE_CARD, Token: "b"},
+		},
+		Items: []*proto.OrderLineItem{
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Price: 300, Count: 1},
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "latte", Price: 350, Count: 2},
+		},
+	}
+
+	env.SetOnChildWorkflowStartedListener(func(workflowInfo *workflow.Info, ctx workflow.Context, args converter.EncodedValues) {
+		for line, status := range []proto.StationOrderItemStatus{
+			proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED,
+			proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_FAILED,
+			proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED,
+		} {
+			setItemStatus(env, workflowInfo.WorkflowExecution.ID, uint32(line+1), status)
+		}
+	})
+
+	var authorizations []string
+	env.OnActivity(activities.AuthorizePayment, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.AuthorizePaymentInput) (*proto.AuthorizePaymentResult, error) {
+		authorizations = append(authorizations, fmt.Sprintf("%s:%d", input.Token, input.Amount))
+		return &proto.AuthorizePaymentResult{Payment: &proto.Payment{Authcode: input.Token}}, nil
+	})
+
+	var captures []string
+	env.OnActivity(activities.CapturePayment, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.CapturePaymentInput) (*proto.CapturePaymentResult, error) {
+		captures = append(captures, fmt.Sprintf("%s:%d", input.Payment.Authcode, input.Amount))
+		return &proto.CapturePaymentResult{}, nil
+	})
+
+	env.ExecuteWorkflow(workflows.Order, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+
+	assert.Equal(t, []string{"a:500", "b:500"}, authorizations)
+	// The failed latte is given back to both cards equally, as they each paid half.
+	assert.Equal(t, []string{"a:325", "b:325"}, captures)
+
+	v, err := env.QueryWorkflow(proto.OrderStatusQuery)
+	assert.NoError(t, err)
+	var status proto.OrderStatus
+	err = v.Get(&status)
+	assert.NoError(t, err)
+
+	assert.Equal(t, proto.OrderPaymentStatus_ORDER_PAYMENT_STATUS_PAID, status.Payment)
+	if assert.Len(t, status.Tenders, 2) {
+		assert.Equal(t, uint32(175), status.Tenders[0].Refunded)
+		assert.Equal(t, uint32(175), status.Tenders[1].Refunded)
+	}
+}
+
+func TestOrderWorkflowSettleRefunded(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Order)
+	registerPayments(env)
+	registerStationOrders(env)
+	admitStationOrders(env)
+
+	input := &proto.OrderInput{
+		Tenders: []*proto.Tender{
+			{Type: proto.TenderType_TENDER_TYPE_CARD, Token: "a", Amount: 500},
+			{Type: proto.TenderType_TENDER_TYPE_CARD, Token: "b"},
+		},
+		Items: []*proto.OrderLineItem{
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Price: 300, Count: 1},
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "latte", Price: 350, Count: 2},
+		},
+	}
+
+	env.SetOnChildWorkflowStartedListener(func(workflowInfo *workflow.Info, ctx workflow.Context, args converter.EncodedValues) {
+		for line := 1; line <= 3; line++ {
+			setItemStatus(env, workflowInfo.WorkflowExecution.ID, uint32(line), proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED)
+		}
+	})
+
+	env.OnActivity(activities.AuthorizePayment, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.AuthorizePaymentInput) (*proto.AuthorizePaymentResult, error) {
+		return &proto.AuthorizePaymentResult{Payment: &proto.Payment{Authcode: input.Token}}, nil
+	})
+
+	// The first card is charged before the second can't be.
+	env.OnActivity(activities.CapturePayment, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.CapturePaymentInput) (*proto.CapturePaymentResult, error) {
+		if input.Payment.Authcode == "b" {
+			return nil, temporal.NewNonRetryableApplicationError("capture failed", "CaptureFailed", nil)
+		}
+		return &proto.CapturePaymentResult{}, nil
+	})
+
+	var refunds []string
+	env.OnActivity(activities.ProcessPaymentRefund, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.ProcessPaymentRefundInput) (*proto.ProcessPaymentRefundResult, error) {
+		refunds = append(refunds, fmt.Sprintf("%s:%d", input.Payment.Authcode, input.Amount))
+		return &proto.ProcessPaymentRefundResult{}, nil
+	})
+
+	activityCalls := orderActivityCalls(env)
+
+	env.ExecuteWorkflow(workflows.Order, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.ErrorContains(t, env.GetWorkflowError(), "capture failed")
+
+	// The uncaptured card is voided and the captured one refunded, most recent first.
+	assert.Equal(t, []string{"AuthorizePayment", "AuthorizePayment", "CapturePayment", "CapturePayment", "VoidPayment", "ProcessPaymentRefund"}, activityCalls())
+	assert.Equal(t, []string{"a:500"}, refunds)
+
+	v, err := env.QueryWorkflow(proto.OrderStatusQuery)
+	assert.NoError(t, err)
+	var status proto.OrderStatus
+	err = v.Get(&status)
+	assert.NoError(t, err)
+
+	if assert.Len(t, status.Tenders, 2) {
+		assert.Equal(t, uint32(500), status.Tenders[0].Refunded)
+		assert.Equal(t, uint32(500), status.Tenders[1].Refunded)
+	}
+}
+
+func TestOrderWorkflowSplitTenderRollback(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Order)
+	env.RegisterActivity(activities.RedeemGiftCard)
+	env.RegisterActivity(activities.RefundGiftCard)
+	registerPayments(env)
+
+	input := &proto.OrderInput{
+		Tenders: []*proto.Tender{
+			{Type: proto.TenderType_TENDER_TYPE_CARD, Token: "a", Amount: 300},
+			{Type: proto.TenderType_TENDER_TYPE_GIFT_CARD, Token: "1234", Amount: 400},
+			{Type: proto.TenderType_TENDER_TYPE_CARD, Token: act.SimulatorDeclineToken},
+		},
+		Items: []*proto.OrderLineItem{
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "latte", Price: 350, Count: 3},
+		},
+	}
+
+	env.OnActivity(activities.RedeemGiftCard, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.RedeemGiftCardInput) (*proto.RedeemGiftCardResult, error) {
+		return &proto.RedeemGiftCardResult{Amount: input.Amount}, nil
+	})
+
+	var refunds []uint32
+	env.OnActivity(activities.RefundGiftCard, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.RefundGiftCardInput) (*proto.RefundGiftCardResult, error) {
+		refunds = append(refunds, input.Amount)
+		return &proto.RefundGiftCardResult{}, nil
+	})
+
+	activityCalls := orderActivityCalls(env)
+
+	env.ExecuteWorkflow(workflows.Order, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.ErrorContains(t, env.GetWorkflowError(), workflows.ErrPaymentDeclined.Error())
+
+	// Earlier tenders are given back, most recent first, when a later one is declined.
+	assert.Equal(t, []string{"AuthorizePayment", "RedeemGiftCard", "AuthorizePayment", "RefundGiftCard", "VoidPayment"}, activityCalls())
+	assert.Equal(t, []uint32{400}, refunds)
+
+	v, err := env.QueryWorkflow(proto.OrderStatusQuery)
+	assert.NoError(t, err)
+	var status proto.OrderStatus
+	err = v.Get(&status)
+	assert.NoError(t, err)
+
+	assert.Equal(t, proto.OrderPaymentStatus_ORDER_PAYMENT_STATUS_FAILED, status.Payment)
+	if assert.Len(t, status.Tenders, 3) {
+		assert.Equal(t, uint32(300), status.Tenders[0].Refunded)
+		assert.Equal(t, uint32(400), status.Tenders[1].Refunded)
+		assert.Equal(t, uint32(0), status.Tenders[2].Amount)
+	}
+}
+
+func TestOrderWorkflowLoyaltyPointsReleased(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Order)
+	env.RegisterActivity(activities.RedeemLoyaltyPoints)
+	env.RegisterActivity(activities.ReleaseLoyaltyPoints)
+	registerPayments(env)
+
+	input := &proto.OrderInput{
+		Email: "test@example.com",
+		Tenders: []*proto.Tender{
+			{Type: proto.TenderType_TENDER_TYPE_LOYALTY_POINTS, Token: "test@example.com"},
+			{Type: proto.TenderType_TENDER_TYPE_CARD, Token: "x"},
+		},
+		Items: []*proto.OrderLineItem{
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Price: 350, Count: 1},
+		},
+	}
+
+	env.OnWorkflow(workflows.StationOrder, mock.Anything, mock.Anything).Return(func(ctx workflow.Context, input *proto.StationOrderInput) (*proto.StationOrderResult, error) {
+		return &proto.StationOrderResult{}, fmt.Errorf("failed")
+	})
+
+	var redeemed []uint32
+	env.OnActivity(activities.RedeemLoyaltyPoints, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.RedeemLoyaltyPointsInput) (*proto.RedeemLoyaltyPointsResult, error) {
+		redeemed = append(redeemed, input.Points)
+		// The customer only has 20 points, the card pays the rest.
+		return &proto.RedeemLoyaltyPointsResult{Points: 20}, nil
+	})
+
+	var released []uint32
+	env.OnActivity(activities.ReleaseLoyaltyPoints, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.ReleaseLoyaltyPointsInput) (*proto.ReleaseLoyaltyPointsResult, error) {
+		released = append(released, input.Points)
+		return &proto.ReleaseLoyaltyPointsResult{}, nil
+	})
+
+	activityCalls := orderActivityCalls(env)
+
+	env.ExecuteWorkflow(workflows.Order, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.Error(t, env.GetWorkflowError())
+
+	assert.Equal(t, []string{"RedeemLoyaltyPoints", "AuthorizePayment", "VoidPayment", "ReleaseLoyaltyPoints"}, activityCalls())
+	assert.Equal(t, []uint32{35}, redeemed)
+	assert.Equal(t, []uint32{20}, released)
+
+	v, err := env.QueryWorkflow(proto.OrderStatusQuery)
+	assert.NoError(t, err)
+	var status proto.OrderStatus
+	err = v.Get(&status)
+	assert.NoError(t, err)
+
+	assert.Equal(t, proto.OrderPaymentStatus_ORDER_PAYMENT_STATUS_VOIDED, status.Payment)
+	if assert.Len(t, status.Tenders, 2) {
+		assert.Equal(t, uint32(200), status.Tenders[0].Amount)
+		assert.Equal(t, uint32(200), status.Tenders[0].Refunded)
+		assert.Equal(t, uint32(150), status.Tenders[1].Amount)
+		assert.Equal(t, uint32(150), status.Tenders[1].Refunded)
+	}
+}
+
+func TestOrderWorkflowLoyaltyFailed(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Order)
+	registerPayments(env)
+	env.RegisterActivity(activities.AddLoyaltyPoints)
+
+	input := &proto.OrderInput{
+		Email:        "test@example.com",
+		PaymentToken: "x",
+		Items: []*proto.OrderLineItem{
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Price: 300, Count: 1},
+		},
+	}
+
+	env.OnWorkflow(workflows.StationOrder, mock.Anything, mock.Anything).Return(func(ctx workflow.Context, input *proto.StationOrderInput) (*proto.StationOrderResult, error) {
+		return &proto.StationOrderResult{}, nil
+	})
+
+	var orderIDs []string
+	env.OnActivity(activities.AddLoyaltyPoints, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.AddLoyaltyPointsInput) (*proto.AddLoyaltyPointsResult, error) {
+		orderIDs = append(orderIDs, input.OrderId)
+		return nil, fmt.Errorf("customer unavailable")
+	})
+
+	env.ExecuteWorkflow(workflows.Order, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	// The order was paid for, only the credit failed.
+	assert.NoError(t, env.GetWorkflowError())
+
+	// Every attempt credits the same order, so the customer can ignore repeats.
+	assert.Greater(t, len(orderIDs), 1)
+	for _, id := range orderIDs {
+		assert.Equal(t, "default-test-workflow-id", id)
+	}
+
+	v, err := env.QueryWorkflow(proto.OrderStatusQuery)
+	assert.NoError(t, err)
+	var status proto.OrderStatus
+	err = v.Get(&status)
+	assert.NoError(t, err)
+
+	assert.Equal(t, proto.OrderPaymentStatus_ORDER_PAYMENT_STATUS_PAID, status.Payment)
+	assert.Equal(t, proto.OrderLoyaltyStatus_ORDER_LOYALTY_STATUS_FAILED, status.Loyalty)
+	assert.Contains(t, status.LoyaltyError, "customer unavailable")
+}
+
+func TestOrderWorkflowCustomerForgotten(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.Order)
+	registerPayments(env)
+	env.RegisterActivity(activities.AddLoyaltyPoints)
+
+	input := &proto.OrderInput{
+		Name:         "Test",
+		Email:        "test@example.com",
+		PaymentToken: "x",
+		Items: []*proto.OrderLineItem{
+			{Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Name: "coffee", Price: 300, Count: 1},
+		},
+	}
+
+	var stationNames []string
+	env.OnWorkflow(workflows.StationOrder, mock.Anything, mock.Anything).Return(func(ctx workflow.Context, input *proto.StationOrderInput) (*proto.StationOrderResult, error) {
+		stationNames = append(stationNames, input.Name)
+		if err := workflow.Sleep(ctx, time.Minute); err != nil {
+			return nil, err
+		}
+		return &proto.StationOrderResult{}, nil
+	})
+
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(proto.OrderStationStatusSignal, &proto.StationOrderStatus{Station: workflows.StationBarista, Name: "Test", Open: true})
+	}, 10*time.Second)
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(proto.OrderCustomerForgottenSignal, nil)
+	}, 20*time.Second)
+	// Stations which haven't heard the customer was forgotten still report their name.
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(proto.OrderStationStatusSignal, &proto.StationOrderStatus{Station: workflows.StationBarista, Name: "Test", Open: true})
+	}, 30*time.Second)
+
+	activityCalls := orderActivityCalls(env)
+
+	env.ExecuteWorkflow(workflows.Order, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+
+	assert.Equal(t, []string{"Test"}, stationNames)
+	// The order is paid for, but the forgotten customer isn't credited.
+	assert.Equal(t, []string{"AuthorizePayment", "CapturePayment"}, activityCalls())
+
+	v, err := env.QueryWorkflow(proto.OrderStatusQuery)
+	assert.NoError(t, err)
+	var status proto.OrderStatus
+	assert.NoError(t, v.Get(&status))
+
+	assert.True(t, status.CustomerForgotten)
+	assert.Empty(t, status.Name)
+	assert.Empty(t, status.Stations[workflows.StationBarista].Name)
+	assert.Equal(t, proto.OrderPaymentStatus_ORDER_PAYMENT_STATUS_PAID, status.Payment)
+	assert.Equal(t, proto.OrderLoyaltyStatus_ORDER_LOYALTY_STATUS_SKIPPED, status.Loyalty)
 }
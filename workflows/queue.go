@@ -0,0 +1,276 @@
+package workflows
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/temporalio/temporal-cafe/proto"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// DefaultStationCapacity is the number of items a station makes at once unless configured.
+const DefaultStationCapacity = 4
+
+// DefaultStationItemTime is how long a station takes to make an item unless configured.
+const DefaultStationItemTime = 2 * time.Minute
+
+// StationQueueCheckInterval is how often a queue checks that the orders it has let through are
+// still running.
+const StationQueueCheckInterval = 5 * time.Minute
+
+// StationQueueID returns the workflow ID of a station's queue.
+func StationQueueID(station string) string {
+	return fmt.Sprintf("station-queue:%s", station)
+}
+
+// StationQueueState holds the orders waiting for or being made by a station. Orders are let
+// through first come first served, except that orders with a higher priority go first.
+type StationQueueState struct {
+	Options *proto.StationOptions
+	Orders  []*proto.StationQueueOrder
+	Seq     uint64
+}
+
+// NewStationQueueState creates a workflow state
+func NewStationQueueState(input *proto.StationQueueInput, state *StationQueueState) *StationQueueState {
+	if state != nil {
+		return state
+	}
+
+	return &StationQueueState{Options: input.Options}
+}
+
+func (s *StationQueueState) capacity() uint32 {
+	if s.Options.GetCapacity() == 0 {
+		return DefaultStationCapacity
+	}
+
+	return s.Options.GetCapacity()
+}
+
+func (s *StationQueueState) itemTime() time.Duration {
+	if s.Options.GetItemTime() == nil {
+		return DefaultStationItemTime
+	}
+
+	return s.Options.GetItemTime().AsDuration()
+}
+
+// sort puts the orders being made first, followed by those waiting in the order they will be let
+// through.
+func (s *StationQueueState) sort() {
+	sort.SliceStable(s.Orders, func(i, j int) bool {
+		a, b := s.Orders[i], s.Orders[j]
+		if a.Admitted != b.Admitted {
+			return a.Admitted
+		}
+		if a.Priority != b.Priority {
+			return a.Priority > b.Priority
+		}
+		return a.Seq < b.Seq
+	})
+}
+
+// update adds a new order to the back of the queue for its priority, or updates the items left on
+// an order already queued. Closed orders leave the queue.
+func (s *StationQueueState) update(order *proto.StationQueueOrder) {
+	for i, o := range s.Orders {
+		if o.OrderId != order.OrderId {
+			continue
+		}
+		if !order.Open {
+			s.Orders = append(s.Orders[:i], s.Orders[i+1:]...)
+			return
+		}
+		o.Name = order.Name
+		o.Priority = order.Priority
+		o.Items = order.Items
+		s.sort()
+		return
+	}
+
+	if !order.Open {
+		return
+	}
+
+	s.Seq++
+	s.Orders = append(s.Orders, &proto.StationQueueOrder{
+		OrderId:  order.OrderId,
+		Name:     order.Name,
+		Priority: order.Priority,
+		Items:    order.Items,
+		Open:     true,
+		Seq:      s.Seq,
+	})
+	s.sort()
+}
+
+// admit lets waiting orders through, in turn, while the station has capacity for their items. An
+// idle station always takes the next order, however many items it has. Returns the orders let
+// through.
+func (s *StationQueueState) admit() []*proto.StationQueueOrder {
+	var making uint32
+	for _, o := range s.Orders {
+		if o.Admitted {
+			making += o.Items
+		}
+	}
+
+	var admitted []*proto.StationQueueOrder
+	for _, o := range s.Orders {
+		if o.Admitted {
+			continue
+		}
+		if making > 0 && making+o.Items > s.capacity() {
+			break
+		}
+		o.Admitted = true
+		making += o.Items
+		admitted = append(admitted, o)
+	}
+
+	return admitted
+}
+
+// status returns each order's position in the queue. The estimated wait for an order is the time
+// to make the items ahead of it, the station's capacity at a time.
+func (s *StationQueueState) status() *proto.StationQueueStatus {
+	status := &proto.StationQueueStatus{
+		Station:  s.Options.GetStation(),
+		Capacity: s.capacity(),
+	}
+
+	var ahead uint32
+	for i, o := range s.Orders {
+		var wait time.Duration
+		if !o.Admitted {
+			rounds := (ahead + s.capacity() - 1) / s.capacity()
+			wait = time.Duration(rounds) * s.itemTime()
+		}
+		status.Orders = append(status.Orders, &proto.StationQueuePosition{
+			Order:         o,
+			Position:      uint32(i + 1),
+			EstimatedWait: durationpb.New(wait),
+		})
+		ahead += o.Items
+	}
+
+	return status
+}
+
+// notify tells a station order it has been let through. Orders which can't be told have finished,
+// so they leave the queue.
+func (s *StationQueueState) notify(ctx workflow.Context, order *proto.StationQueueOrder) {
+	err := workflow.SignalExternalWorkflow(ctx, order.OrderId, "", proto.StationOrderAdmittedSignal, nil).Get(ctx, nil)
+	if err != nil {
+		workflow.GetLogger(ctx).Warn("failed to admit station order", "order", order.OrderId, "error", err)
+		s.update(&proto.StationQueueOrder{OrderId: order.OrderId, Open: false})
+	}
+}
+
+// admittedIDs returns the orders which have been let through.
+func (s *StationQueueState) admittedIDs() []string {
+	var ids []string
+	for _, o := range s.Orders {
+		if o.Admitted {
+			ids = append(ids, o.OrderId)
+		}
+	}
+
+	return ids
+}
+
+// removeClosed drops the orders let through whose workflows are no longer running, freeing the
+// capacity they held. Orders which can't be checked are left for the next check.
+func (s *StationQueueState) removeClosed(ctx workflow.Context) {
+	ids := s.admittedIDs()
+	if len(ids) == 0 {
+		return
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 3,
+		},
+	})
+
+	var result proto.FindClosedStationOrdersResult
+	err := workflow.ExecuteActivity(ctx, a.FindClosedStationOrders, &proto.FindClosedStationOrdersInput{OrderIds: ids}).Get(ctx, &result)
+	if err != nil {
+		workflow.GetLogger(ctx).Warn("failed to check station orders", "error", err)
+		return
+	}
+	for _, id := range result.OrderIds {
+		workflow.GetLogger(ctx).Warn("removing closed station order", "order", id)
+		s.update(&proto.StationQueueOrder{OrderId: id, Open: false})
+	}
+}
+
+// StationQueue orders the work for a station. Station orders join the queue as they start and
+// report the items they have left, and are told when the station has capacity to start them.
+func StationQueue(ctx workflow.Context, input *proto.StationQueueInput, state *StationQueueState) error {
+	wf := NewStationQueueState(input, state)
+
+	err := workflow.SetQueryHandler(ctx, proto.StationQueueStatusQuery, func() (*proto.StationQueueStatus, error) {
+		return wf.status(), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	ch := workflow.GetSignalChannel(ctx, proto.StationQueueOrderSignal)
+	ready := func() bool {
+		return ch.Len() > 0 || workflow.GetInfo(ctx).GetContinueAsNewSuggested()
+	}
+
+	check := workflow.Now(ctx).Add(StationQueueCheckInterval)
+	for {
+		// Notifying an order may remove it from the queue, freeing capacity for the next.
+		for admitted := wf.admit(); len(admitted) > 0; admitted = wf.admit() {
+			for _, o := range admitted {
+				wf.notify(ctx, o)
+			}
+		}
+
+		// Orders which have been let through are checked on now and then, as an order which is
+		// terminated never leaves the queue and would hold the station's capacity for good.
+		if len(wf.admittedIDs()) == 0 {
+			if err := workflow.Await(ctx, ready); err != nil {
+				return err
+			}
+			check = workflow.Now(ctx).Add(StationQueueCheckInterval)
+		} else {
+			ok := false
+			if wait := check.Sub(workflow.Now(ctx)); wait > 0 {
+				var err error
+				ok, err = workflow.AwaitWithTimeout(ctx, wait, ready)
+				if err != nil {
+					return err
+				}
+			}
+			if !ok {
+				wf.removeClosed(ctx)
+				check = workflow.Now(ctx).Add(StationQueueCheckInterval)
+				continue
+			}
+		}
+
+		for {
+			var order proto.StationQueueOrder
+			if !ch.ReceiveAsync(&order) {
+				break
+			}
+			wf.update(&order)
+		}
+
+		if workflow.GetInfo(ctx).GetContinueAsNewSuggested() {
+			break
+		}
+	}
+
+	return workflow.NewContinueAsNewError(ctx, StationQueue, input, wf)
+}
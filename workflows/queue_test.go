@@ -0,0 +1,374 @@
+package workflows_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/temporalio/temporal-cafe/proto"
+	"github.com/temporalio/temporal-cafe/workflows"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestStationQueueWorkflow(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.StationQueue)
+
+	input := &proto.StationQueueInput{
+		Options: &proto.StationOptions{
+			Station:  workflows.StationBarista,
+			Capacity: 3,
+			ItemTime: durationpb.New(time.Minute),
+		},
+	}
+
+	var admitted []string
+	env.OnSignalExternalWorkflow(mock.Anything, mock.Anything, "", proto.StationOrderAdmittedSignal, mock.Anything).Return(func(namespace, workflowID, runID, signalName string, arg interface{}) error {
+		admitted = append(admitted, workflowID)
+		return nil
+	})
+
+	queue := func(order *proto.StationQueueOrder) {
+		env.SignalWorkflow(proto.StationQueueOrderSignal, order)
+	}
+
+	status := func() *proto.StationQueueStatus {
+		v, err := env.QueryWorkflow(proto.StationQueueStatusQuery)
+		assert.NoError(t, err)
+		var status proto.StationQueueStatus
+		assert.NoError(t, v.Get(&status))
+		return &status
+	}
+
+	env.RegisterDelayedCallback(func() {
+		queue(&proto.StationQueueOrder{OrderId: "a", Items: 2, Open: true})
+		queue(&proto.StationQueueOrder{OrderId: "b", Items: 2, Open: true})
+		// A rush goes ahead of orders already waiting.
+		queue(&proto.StationQueueOrder{OrderId: "c", Items: 1, Priority: 1, Open: true})
+	}, time.Second)
+
+	env.RegisterDelayedCallback(func() {
+		s := status()
+		assert.Equal(t, uint32(3), s.Capacity)
+		if assert.Len(t, s.Orders, 3) {
+			assert.Equal(t, "a", s.Orders[0].Order.OrderId)
+			assert.True(t, s.Orders[0].Order.Admitted)
+			assert.Equal(t, "c", s.Orders[1].Order.OrderId)
+			assert.True(t, s.Orders[1].Order.Admitted)
+
+			// The station is full until a's items are made.
+			assert.Equal(t, "b", s.Orders[2].Order.OrderId)
+			assert.False(t, s.Orders[2].Order.Admitted)
+			assert.Equal(t, uint32(3), s.Orders[2].Position)
+			assert.Equal(t, time.Minute, s.Orders[2].EstimatedWait.AsDuration())
+		}
+		assert.Equal(t, []string{"a", "c"}, admitted)
+
+		queue(&proto.StationQueueOrder{OrderId: "a", Items: 1, Open: true})
+	}, 2*time.Second)
+
+	env.RegisterDelayedCallback(func() {
+		assert.Equal(t, []string{"a", "c"}, admitted)
+
+		queue(&proto.StationQueueOrder{OrderId: "a", Open: false})
+	}, 3*time.Second)
+
+	env.RegisterDelayedCallback(func() {
+		s := status()
+		if assert.Len(t, s.Orders, 2) {
+			assert.Equal(t, "c", s.Orders[0].Order.OrderId)
+			assert.Equal(t, "b", s.Orders[1].Order.OrderId)
+			assert.Equal(t, uint32(2), s.Orders[1].Position)
+			assert.Zero(t, s.Orders[1].EstimatedWait.AsDuration())
+		}
+		assert.Equal(t, []string{"a", "c", "b"}, admitted)
+
+		env.SetContinueAsNewSuggested(true)
+		queue(&proto.StationQueueOrder{OrderId: "c", Open: false})
+	}, 4*time.Second)
+
+	env.ExecuteWorkflow(workflows.StationQueue, input, nil)
+
+	assert.True(t, workflow.IsContinueAsNewError(env.GetWorkflowError()))
+}
+
+func TestStationQueueWorkflowRush(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.StationQueue)
+
+	input := &proto.StationQueueInput{
+		Options: &proto.StationOptions{
+			Station:  workflows.StationKitchen,
+			Capacity: 2,
+		},
+	}
+
+	var admitted []string
+	env.OnSignalExternalWorkflow(mock.Anything, mock.Anything, "", proto.StationOrderAdmittedSignal, mock.Anything).Return(func(namespace, workflowID, runID, signalName string, arg interface{}) error {
+		admitted = append(admitted, workflowID)
+		return nil
+	})
+
+	queue := func(order *proto.StationQueueOrder) {
+		env.SignalWorkflow(proto.StationQueueOrderSignal, order)
+	}
+
+	env.RegisterDelayedCallback(func() {
+		queue(&proto.StationQueueOrder{OrderId: "a", Items: 2, Open: true})
+		queue(&proto.StationQueueOrder{OrderId: "b", Items: 2, Open: true})
+		queue(&proto.StationQueueOrder{OrderId: "c", Items: 2, Open: true})
+	}, time.Second)
+
+	// c is rushed while it waits behind b.
+	env.RegisterDelayedCallback(func() {
+		queue(&proto.StationQueueOrder{OrderId: "c", Items: 2, Priority: 1, Open: true})
+	}, 2*time.Second)
+
+	env.RegisterDelayedCallback(func() {
+		v, err := env.QueryWorkflow(proto.StationQueueStatusQuery)
+		assert.NoError(t, err)
+		var status proto.StationQueueStatus
+		assert.NoError(t, v.Get(&status))
+		if assert.Len(t, status.Orders, 3) {
+			assert.Equal(t, "c", status.Orders[1].Order.OrderId)
+			assert.Equal(t, uint32(2), status.Orders[1].Position)
+			assert.Equal(t, "b", status.Orders[2].Order.OrderId)
+		}
+		assert.Equal(t, []string{"a"}, admitted)
+
+		queue(&proto.StationQueueOrder{OrderId: "a", Open: false})
+	}, 3*time.Second)
+
+	env.RegisterDelayedCallback(func() {
+		assert.Equal(t, []string{"a", "c"}, admitted)
+
+		env.SetContinueAsNewSuggested(true)
+		queue(&proto.StationQueueOrder{OrderId: "c", Open: false})
+	}, 4*time.Second)
+
+	env.ExecuteWorkflow(workflows.StationQueue, input, nil)
+
+	assert.True(t, workflow.IsContinueAsNewError(env.GetWorkflowError()))
+}
+
+func TestStationQueueWorkflowClosedOrders(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.StationQueue)
+	env.RegisterActivity(activities.FindClosedStationOrders)
+
+	input := &proto.StationQueueInput{
+		Options: &proto.StationOptions{
+			Station:  workflows.StationKitchen,
+			Capacity: 1,
+		},
+	}
+
+	var admitted []string
+	env.OnSignalExternalWorkflow(mock.Anything, mock.Anything, "", proto.StationOrderAdmittedSignal, mock.Anything).Return(func(namespace, workflowID, runID, signalName string, arg interface{}) error {
+		admitted = append(admitted, workflowID)
+		return nil
+	})
+
+	// a is terminated while it is being made, so it never tells the queue it has closed.
+	var checked [][]string
+	env.OnActivity(activities.FindClosedStationOrders, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.FindClosedStationOrdersInput) (*proto.FindClosedStationOrdersResult, error) {
+		checked = append(checked, input.OrderIds)
+		return &proto.FindClosedStationOrdersResult{OrderIds: []string{"a"}}, nil
+	})
+
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(proto.StationQueueOrderSignal, &proto.StationQueueOrder{OrderId: "a", Items: 1, Open: true})
+		env.SignalWorkflow(proto.StationQueueOrderSignal, &proto.StationQueueOrder{OrderId: "b", Items: 1, Open: true})
+	}, time.Second)
+
+	env.RegisterDelayedCallback(func() {
+		assert.Equal(t, []string{"a"}, admitted)
+	}, workflows.StationQueueCheckInterval-time.Second)
+
+	env.RegisterDelayedCallback(func() {
+		assert.Equal(t, []string{"a", "b"}, admitted)
+
+		env.SetContinueAsNewSuggested(true)
+		env.SignalWorkflow(proto.StationQueueOrderSignal, &proto.StationQueueOrder{OrderId: "b", Open: false})
+	}, workflows.StationQueueCheckInterval+2*time.Second)
+
+	env.ExecuteWorkflow(workflows.StationQueue, input, nil)
+
+	assert.True(t, workflow.IsContinueAsNewError(env.GetWorkflowError()))
+	if assert.NotEmpty(t, checked) {
+		assert.Equal(t, []string{"a"}, checked[0])
+	}
+}
+
+func TestStationWorkflowQueued(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.StationOrder)
+	env.RegisterActivity(activities.QueueStationOrder)
+
+	var queued []*proto.StationQueueOrder
+	env.OnActivity(activities.QueueStationOrder, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.QueueStationOrderInput) (*proto.QueueStationOrderResult, error) {
+		queued = append(queued, input.Order)
+		return &proto.QueueStationOrderResult{}, nil
+	})
+
+	input := &proto.StationOrderInput{
+		Station:  workflows.StationBarista,
+		Priority: 1,
+		Items: []*proto.OrderLineItem{
+			{Name: "latte", Count: 2},
+		},
+	}
+
+	env.RegisterDelayedCallback(func() {
+		env.UpdateWorkflow(proto.StationOrderItemStatusUpdate, "waiting", &updateCallback{
+			reject: func(err error) {
+				assert.ErrorContains(t, err, "order is waiting in the barista queue")
+			},
+			complete: func(interface{}, error) {
+				assert.Fail(t, "item started before the order was let through")
+			},
+		}, &proto.StationOrderItemStatusInput{Line: 1, Status: proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_STARTED})
+
+		env.SignalWorkflow(proto.StationOrderAdmittedSignal, nil)
+	}, time.Second)
+
+	for i, line := range []uint32{1, 2} {
+		line := line
+		env.RegisterDelayedCallback(func() {
+			env.UpdateWorkflow(proto.StationOrderItemStatusUpdate, fmt.Sprintf("completed-%d", line), &updateCallback{
+				reject: func(err error) {
+					assert.Fail(t, "unexpected rejection", err)
+				},
+			}, &proto.StationOrderItemStatusInput{Line: line, Status: proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED})
+		}, time.Duration(i+2)*time.Second)
+	}
+
+	env.ExecuteWorkflow(workflows.StationOrder, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+
+	// The queue is told as the order's items are made, and when it closes.
+	if assert.Len(t, queued, 3) {
+		assert.Equal(t, uint32(1), queued[0].Priority)
+		assert.Equal(t, uint32(2), queued[0].Items)
+		assert.Equal(t, uint32(1), queued[1].Items)
+		assert.False(t, queued[2].Open)
+	}
+}
+
+func TestStationWorkflowRushed(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.StationOrder)
+	env.RegisterActivity(activities.QueueStationOrder)
+
+	var queued []*proto.StationQueueOrder
+	env.OnActivity(activities.QueueStationOrder, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.QueueStationOrderInput) (*proto.QueueStationOrderResult, error) {
+		queued = append(queued, input.Order)
+		return &proto.QueueStationOrderResult{}, nil
+	})
+
+	input := &proto.StationOrderInput{
+		Station: workflows.StationKitchen,
+		Items: []*proto.OrderLineItem{
+			{Name: "bagel", Count: 1},
+		},
+	}
+
+	env.RegisterDelayedCallback(func() {
+		env.UpdateWorkflow(proto.StationOrderPriorityUpdate, "rush", &updateCallback{
+			reject: func(err error) {
+				assert.Fail(t, "unexpected rejection", err)
+			},
+		}, &proto.StationOrderPriorityInput{Priority: 1})
+	}, time.Second)
+
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(proto.StationOrderAdmittedSignal, nil)
+	}, 2*time.Second)
+
+	env.RegisterDelayedCallback(func() {
+		// Once the order is being made there is nothing to rush.
+		env.UpdateWorkflow(proto.StationOrderPriorityUpdate, "too-late", &updateCallback{
+			reject: func(err error) {
+				assert.ErrorContains(t, err, "already been let through the kitchen queue")
+			},
+			complete: func(interface{}, error) {
+				assert.Fail(t, "order rushed after it was let through")
+			},
+		}, &proto.StationOrderPriorityInput{Priority: 2})
+
+		env.UpdateWorkflow(proto.StationOrderItemStatusUpdate, "completed", &updateCallback{
+			reject: func(err error) {
+				assert.Fail(t, "unexpected rejection", err)
+			},
+		}, &proto.StationOrderItemStatusInput{Line: 1, Status: proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED})
+	}, 3*time.Second)
+
+	env.ExecuteWorkflow(workflows.StationOrder, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+
+	// The queue hears of the rush for the order it already has.
+	if assert.Len(t, queued, 3) {
+		assert.Equal(t, uint32(0), queued[0].Priority)
+		assert.Equal(t, queued[0].OrderId, queued[1].OrderId)
+		assert.Equal(t, uint32(1), queued[1].Priority)
+		assert.Equal(t, uint32(1), queued[1].Items)
+		assert.False(t, queued[2].Open)
+	}
+}
+
+func TestStationWorkflowLeavesQueue(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.StationOrder)
+	env.RegisterActivity(activities.QueueStationOrder)
+
+	// The queue can't be reached for a while after the order closes.
+	var leaveAttempts int
+	env.OnActivity(activities.QueueStationOrder, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.QueueStationOrderInput) (*proto.QueueStationOrderResult, error) {
+		if input.Order.Open {
+			_ = env.SignalWorkflowByID(input.Order.OrderId, proto.StationOrderAdmittedSignal, nil)
+			return &proto.QueueStationOrderResult{}, nil
+		}
+		leaveAttempts++
+		if leaveAttempts < 5 {
+			return nil, fmt.Errorf("station queue unavailable")
+		}
+		return &proto.QueueStationOrderResult{}, nil
+	})
+
+	input := &proto.StationOrderInput{
+		Station: workflows.StationBarista,
+		Items: []*proto.OrderLineItem{
+			{Name: "latte", Count: 1},
+		},
+	}
+
+	env.RegisterDelayedCallback(func() {
+		updateItemStatus(t, env, &proto.StationOrderItemStatusInput{Line: 1, Status: proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED})
+	}, time.Second)
+
+	env.ExecuteWorkflow(workflows.StationOrder, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+
+	// Leaving the queue frees the station's capacity, so it is retried until it gets through.
+	assert.Equal(t, 5, leaveAttempts)
+}
@@ -0,0 +1,85 @@
+package workflows
+
+import (
+	"fmt"
+
+	"github.com/temporalio/temporal-cafe/proto"
+	"go.temporal.io/sdk/workflow"
+)
+
+// OrderSequenceID is the workflow ID of the single sequence used to number orders
+const OrderSequenceID = "order-sequence"
+
+// OrderSequenceKeyLimit is the number of idempotency keys remembered by the sequence
+const OrderSequenceKeyLimit = 1000
+
+type OrderSequenceKey struct {
+	Key string
+	ID  string
+}
+
+type OrderSequenceState struct {
+	Date string
+	Last uint32
+	Keys []OrderSequenceKey
+}
+
+// NewOrderSequenceState creates a workflow state
+func NewOrderSequenceState(state *OrderSequenceState) *OrderSequenceState {
+	if state != nil {
+		return state
+	}
+
+	return &OrderSequenceState{}
+}
+
+// next returns the next order ID for the day, restarting the count when the date changes.
+// If the idempotency key has been seen before, the ID allocated for it is returned instead.
+func (s *OrderSequenceState) next(ctx workflow.Context, key string) (string, bool) {
+	if key != "" {
+		for _, k := range s.Keys {
+			if k.Key == key {
+				return k.ID, true
+			}
+		}
+	}
+
+	date := workflow.Now(ctx).Format("20060102")
+	if date != s.Date {
+		s.Date = date
+		s.Last = 0
+	}
+	s.Last++
+
+	id := fmt.Sprintf("%s-%04d", s.Date, s.Last)
+
+	if key != "" {
+		s.Keys = append(s.Keys, OrderSequenceKey{Key: key, ID: id})
+		if len(s.Keys) > OrderSequenceKeyLimit {
+			s.Keys = s.Keys[len(s.Keys)-OrderSequenceKeyLimit:]
+		}
+	}
+
+	return id, false
+}
+
+func OrderSequence(ctx workflow.Context, state *OrderSequenceState) error {
+	wf := NewOrderSequenceState(state)
+
+	err := workflow.SetUpdateHandler(ctx, proto.OrderSequenceNextUpdate, func(ctx workflow.Context, input *proto.OrderSequenceNextInput) (*proto.OrderSequenceNextResult, error) {
+		id, duplicate := wf.next(ctx, input.IdempotencyKey)
+		return &proto.OrderSequenceNextResult{Id: id, Duplicate: duplicate}, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = workflow.Await(ctx, func() bool {
+		return workflow.GetInfo(ctx).GetContinueAsNewSuggested()
+	})
+	if err != nil {
+		return err
+	}
+
+	return workflow.NewContinueAsNewError(ctx, OrderSequence, wf)
+}
@@ -0,0 +1,89 @@
+package workflows_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/temporalio/temporal-cafe/proto"
+	"github.com/temporalio/temporal-cafe/workflows"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestOrderSequenceWorkflow(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.OrderSequence)
+	env.SetStartTime(time.Date(2023, 10, 17, 9, 0, 0, 0, time.UTC))
+
+	var ids []string
+	next := &updateCallback{
+		reject: func(err error) {
+			assert.Fail(t, "unexpected rejection", err)
+		},
+		complete: func(result interface{}, err error) {
+			assert.NoError(t, err)
+			ids = append(ids, result.(*proto.OrderSequenceNextResult).Id)
+		},
+	}
+
+	env.RegisterDelayedCallback(func() {
+		env.UpdateWorkflow(proto.OrderSequenceNextUpdate, "1", next, &proto.OrderSequenceNextInput{})
+		env.UpdateWorkflow(proto.OrderSequenceNextUpdate, "2", next, &proto.OrderSequenceNextInput{})
+	}, time.Hour)
+
+	env.RegisterDelayedCallback(func() {
+		env.SetContinueAsNewSuggested(true)
+		env.UpdateWorkflow(proto.OrderSequenceNextUpdate, "3", next, &proto.OrderSequenceNextInput{})
+	}, 24*time.Hour)
+
+	env.ExecuteWorkflow(workflows.OrderSequence, &workflows.OrderSequenceState{Date: "20231017", Last: 41})
+
+	assert.True(t, workflow.IsContinueAsNewError(env.GetWorkflowError()))
+	assert.Equal(t, []string{"20231017-0042", "20231017-0043", "20231018-0001"}, ids)
+}
+
+func TestOrderSequenceWorkflowIdempotencyKey(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.OrderSequence)
+	env.SetStartTime(time.Date(2023, 10, 17, 9, 0, 0, 0, time.UTC))
+
+	var results []*proto.OrderSequenceNextResult
+	next := &updateCallback{
+		reject: func(err error) {
+			assert.Fail(t, "unexpected rejection", err)
+		},
+		complete: func(result interface{}, err error) {
+			assert.NoError(t, err)
+			results = append(results, result.(*proto.OrderSequenceNextResult))
+		},
+	}
+
+	env.RegisterDelayedCallback(func() {
+		env.UpdateWorkflow(proto.OrderSequenceNextUpdate, "1", next, &proto.OrderSequenceNextInput{IdempotencyKey: "a"})
+		env.UpdateWorkflow(proto.OrderSequenceNextUpdate, "2", next, &proto.OrderSequenceNextInput{IdempotencyKey: "b"})
+		env.UpdateWorkflow(proto.OrderSequenceNextUpdate, "3", next, &proto.OrderSequenceNextInput{IdempotencyKey: "a"})
+	}, time.Hour)
+
+	env.RegisterDelayedCallback(func() {
+		env.SetContinueAsNewSuggested(true)
+		env.UpdateWorkflow(proto.OrderSequenceNextUpdate, "4", next, &proto.OrderSequenceNextInput{})
+	}, 2*time.Hour)
+
+	env.ExecuteWorkflow(workflows.OrderSequence, nil)
+
+	assert.True(t, workflow.IsContinueAsNewError(env.GetWorkflowError()))
+
+	var ids []string
+	var duplicates []bool
+	for _, r := range results {
+		ids = append(ids, r.Id)
+		duplicates = append(duplicates, r.Duplicate)
+	}
+	assert.Equal(t, []string{"20231017-0001", "20231017-0002", "20231017-0001", "20231017-0003"}, ids)
+	assert.Equal(t, []bool{false, false, true, false}, duplicates)
+}
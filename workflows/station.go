@@ -0,0 +1,474 @@
+package workflows
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/temporalio/temporal-cafe/proto"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// Stations which make the items in an order
+const (
+	StationKitchen = "kitchen"
+	StationBarista = "barista"
+)
+
+// StationMemo is the memo field holding the name of the station a station order workflow is for.
+const StationMemo = "station"
+
+// StationOrderLineInvalidError is the error type used when rejecting a change to a line item which
+// is not on the station order.
+const StationOrderLineInvalidError = "StationOrderLineInvalid"
+
+// DefaultMaxRemakes is how many times a failed item is remade under the default routing.
+const DefaultMaxRemakes = 2
+
+// DefaultStationRouting sends food to the kitchen and beverages to the barista.
+func DefaultStationRouting() *proto.StationRouting {
+	return &proto.StationRouting{
+		Routes: []*proto.StationRoute{
+			{Station: StationKitchen, Type: proto.ProductType_PRODUCT_TYPE_FOOD},
+			{Station: StationBarista, Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE},
+		},
+		MaxRemakes: DefaultMaxRemakes,
+	}
+}
+
+// routeMatches reports whether a route applies to a menu item.
+func routeMatches(route *proto.StationRoute, t proto.ProductType, category string, name string) bool {
+	if route.Type != proto.ProductType_PRODUCT_TYPE_UNKNOWN && route.Type != t {
+		return false
+	}
+	if route.Category != "" && route.Category != category {
+		return false
+	}
+	if len(route.Items) == 0 {
+		return true
+	}
+	for _, item := range route.Items {
+		if item == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// StationFor returns the station which makes a menu item, or "" if no route matches it. Items of
+// unknown type are never made.
+func StationFor(routing *proto.StationRouting, t proto.ProductType, category string, name string) string {
+	if t == proto.ProductType_PRODUCT_TYPE_UNKNOWN {
+		return ""
+	}
+	for _, route := range routing.GetRoutes() {
+		if routeMatches(route, t, category, name) {
+			return route.Station
+		}
+	}
+
+	return ""
+}
+
+// Stations returns the names of the stations items are routed to, in alphabetical order.
+func Stations(routing *proto.StationRouting) []string {
+	var stations []string
+	seen := make(map[string]bool)
+	for _, route := range routing.GetRoutes() {
+		if !seen[route.Station] {
+			seen[route.Station] = true
+			stations = append(stations, route.Station)
+		}
+	}
+	sort.Strings(stations)
+
+	return stations
+}
+
+var stationNamePattern = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+
+// ValidateStationRouting checks that every route names a station which can be used in URLs and
+// commands, and that every product type has a station, whatever its category or name.
+func ValidateStationRouting(routing *proto.StationRouting) error {
+	for i, route := range routing.GetRoutes() {
+		if !stationNamePattern.MatchString(route.Station) {
+			return fmt.Errorf("route %d: invalid station name: %q", i+1, route.Station)
+		}
+	}
+
+	routed := Stations(routing)
+	for _, options := range routing.GetStations() {
+		i := sort.SearchStrings(routed, options.Station)
+		if i == len(routed) || routed[i] != options.Station {
+			return fmt.Errorf("options for unrouted station: %q", options.Station)
+		}
+		if options.GetItemTime().AsDuration() < 0 {
+			return fmt.Errorf("station %s: negative item time", options.Station)
+		}
+	}
+
+	for t := range proto.ProductType_name {
+		pt := proto.ProductType(t)
+		if pt == proto.ProductType_PRODUCT_TYPE_UNKNOWN {
+			continue
+		}
+		covered := false
+		for _, route := range routing.GetRoutes() {
+			if (route.Type == proto.ProductType_PRODUCT_TYPE_UNKNOWN || route.Type == pt) && route.Category == "" && len(route.Items) == 0 {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return fmt.Errorf("no station for every item of type: %s", pt)
+		}
+	}
+
+	return nil
+}
+
+// itemStatusTransitions lists the statuses staff can move an item to from each status. Items are
+// cancelled by the order and remade by the station order, never by staff.
+var itemStatusTransitions = map[proto.StationOrderItemStatus][]proto.StationOrderItemStatus{
+	proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_PENDING: {
+		proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_STARTED,
+		proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED,
+		proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_FAILED,
+	},
+	proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_STARTED: {
+		proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED,
+		proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_FAILED,
+	},
+}
+
+// StationOrderWorkflow tracks the items a station is making for an order.
+type StationOrderWorkflow struct {
+	Status *proto.StationOrderStatus
+	// MaxRemakes is how many times a failed item is remade before it is reported as failed.
+	MaxRemakes uint32
+	// Priority places the order ahead of others in the station's queue.
+	Priority uint32
+
+	// queued is the order as last reported to the station's queue.
+	queued            *proto.StationQueueOrder
+	fulfilmentStarted bool
+	// changed wakes the workflow to report items changed by an update.
+	changed workflow.Channel
+	err     error
+}
+
+func NewStationOrderWorkflow(station string, name string, items []*proto.OrderLineItem) *StationOrderWorkflow {
+	wf := &StationOrderWorkflow{Status: &proto.StationOrderStatus{Station: station, Name: name, Open: true}}
+	wf.addItems(items)
+
+	return wf
+}
+
+func StationOrder(ctx workflow.Context, input *proto.StationOrderInput) (*proto.StationOrderResult, error) {
+	return newStationOrder(ctx, input).run(ctx)
+}
+
+func newStationOrder(ctx workflow.Context, input *proto.StationOrderInput) *StationOrderWorkflow {
+	wf := NewStationOrderWorkflow(input.Station, input.Name, input.Items)
+	wf.MaxRemakes = input.MaxRemakes
+	wf.Priority = input.Priority
+	wf.changed = workflow.NewBufferedChannel(ctx, 1)
+
+	return wf
+}
+
+// run handles the station order's query and item status updates until its items are finished with.
+func (s *StationOrderWorkflow) run(ctx workflow.Context) (*proto.StationOrderResult, error) {
+	err := workflow.SetQueryHandler(ctx, proto.StationOrderStatusQuery, func() (*proto.StationOrderStatus, error) {
+		return s.Status, nil
+	})
+	if err != nil {
+		return &proto.StationOrderResult{}, err
+	}
+
+	err = workflow.SetUpdateHandlerWithOptions(ctx, proto.StationOrderItemStatusUpdate, s.handleItemStatus, workflow.UpdateHandlerOptions{
+		Validator: s.validateItemStatus,
+	})
+	if err != nil {
+		return &proto.StationOrderResult{}, err
+	}
+
+	err = workflow.SetUpdateHandlerWithOptions(ctx, proto.StationOrderPriorityUpdate, s.handlePriority, workflow.UpdateHandlerOptions{
+		Validator: s.validatePriority,
+	})
+	if err != nil {
+		return &proto.StationOrderResult{}, err
+	}
+
+	err = s.waitForItems(ctx)
+
+	return &proto.StationOrderResult{}, err
+}
+
+func (s *StationOrderWorkflow) waitForItems(ctx workflow.Context) error {
+	sel := workflow.NewSelector(ctx)
+
+	var fulfilmentSignalled = false
+
+	// Items can be started once the station's queue lets the order through. Admission and new items
+	// are handled ahead of item statuses, which may have been sent for them while the order was
+	// joining the queue.
+	admittedCh := workflow.GetSignalChannel(ctx, proto.StationOrderAdmittedSignal)
+	sel.AddReceive(admittedCh, func(c workflow.ReceiveChannel, _ bool) {
+		c.Receive(ctx, nil)
+		s.Status.Admitted = true
+	})
+
+	// Listen for items being added to or removed from the order
+	itemsCh := workflow.GetSignalChannel(ctx, proto.StationOrderItemsSignal)
+	sel.AddReceive(itemsCh, func(c workflow.ReceiveChannel, _ bool) {
+		var signal proto.StationOrderItemsUpdate
+		c.Receive(ctx, &signal)
+
+		s.addItems(signal.Add)
+		s.removeItems(signal.Remove)
+		s.Status.Open = !s.isOrderCompleted()
+	})
+
+	// Items changed by updates are already applied, they only need reporting.
+	sel.AddReceive(s.changed, func(c workflow.ReceiveChannel, _ bool) {
+		c.Receive(ctx, nil)
+	})
+
+	// Listen for Workflow cancellation
+	sel.AddReceive(ctx.Done(), func(workflow.ReceiveChannel, bool) {
+		s.err = temporal.NewCanceledError()
+	})
+
+	s.queue(ctx)
+	if err := s.signalStatus(ctx); err != nil {
+		return err
+	}
+
+	// Signals which arrived as we closed the order may add new items, so handle those too.
+	for s.Status.Open || sel.HasPending() {
+		sel.Select(ctx)
+		if errors.Is(s.err, workflow.ErrCanceled) {
+			// Free the order's place in the queue.
+			s.Status.Open = false
+			s.queue(ctx)
+			return nil
+		}
+		s.queue(ctx)
+		if err := s.signalStatus(ctx); err != nil {
+			return err
+		}
+		if s.err != nil {
+			return s.err
+		}
+		if s.fulfilmentStarted && !fulfilmentSignalled {
+			if err := s.signalFulfilmentStarted(ctx); err != nil {
+				return err
+			}
+			fulfilmentSignalled = true
+		}
+	}
+
+	return nil
+}
+
+// remainingItems counts the items which haven't been made yet.
+func (s *StationOrderWorkflow) remainingItems() uint32 {
+	var count uint32
+	for _, v := range s.Status.Items {
+		switch v.Status {
+		case proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_PENDING, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_STARTED:
+			count++
+		}
+	}
+
+	return count
+}
+
+// queue tells the station's queue how many items the order has left to make, or that it has closed.
+// An order the queue can't be told about is let through rather than left waiting.
+func (s *StationOrderWorkflow) queue(ctx workflow.Context) {
+	order := &proto.StationQueueOrder{
+		OrderId:  workflow.GetInfo(ctx).WorkflowExecution.ID,
+		Name:     s.Status.Name,
+		Priority: s.Priority,
+		Items:    s.remainingItems(),
+		Open:     s.Status.Open,
+	}
+	if s.queued != nil && s.queued.Priority == order.Priority && s.queued.Items == order.Items && s.queued.Open == order.Open {
+		return
+	}
+
+	// An open order is let through if the queue can't be told about it, but the station's capacity
+	// is held for the order until the queue hears it has closed, so that is retried until it does.
+	retry := &temporal.RetryPolicy{MaximumAttempts: 3}
+	if !order.Open {
+		retry.MaximumAttempts = 0
+	}
+
+	// A cancelled order still needs to leave the queue.
+	ctx, _ = workflow.NewDisconnectedContext(ctx)
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Second,
+		RetryPolicy:         retry,
+	})
+
+	err := workflow.ExecuteActivity(ctx, a.QueueStationOrder, &proto.QueueStationOrderInput{Station: s.Status.Station, Order: order}).Get(ctx, nil)
+	if err != nil {
+		workflow.GetLogger(ctx).Error("failed to queue station order", "error", err)
+		s.Status.Admitted = true
+	}
+	s.queued = order
+}
+
+func itemStatusName(status proto.StationOrderItemStatus) string {
+	return strings.ToLower(strings.TrimPrefix(status.String(), "STATION_ORDER_ITEM_STATUS_"))
+}
+
+// validateItemStatus checks that staff can move the item to the new status.
+func (s *StationOrderWorkflow) validateItemStatus(ctx workflow.Context, input *proto.StationOrderItemStatusInput) error {
+	if input.Line < 1 || input.Line > uint32(len(s.Status.Items)) {
+		return temporal.NewApplicationError(fmt.Sprintf("invalid line item: %d", input.Line), StationOrderLineInvalidError)
+	}
+
+	// Adjust item number because array is 0-indexed.
+	item := s.Status.Items[input.Line-1]
+	if item.Status == proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_PENDING && !s.Status.Admitted &&
+		input.Status != proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_FAILED {
+		return fmt.Errorf("order is waiting in the %s queue", s.Status.Station)
+	}
+	for _, next := range itemStatusTransitions[item.Status] {
+		if next == input.Status {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("line item %d cannot go from %s to %s", input.Line, itemStatusName(item.Status), itemStatusName(input.Status))
+}
+
+func (s *StationOrderWorkflow) handleItemStatus(ctx workflow.Context, input *proto.StationOrderItemStatusInput) (*proto.StationOrderStatus, error) {
+	s.updateItem(ctx, input)
+	s.changed.SendAsync(nil)
+
+	return s.Status, nil
+}
+
+// validatePriority only lets staff move orders which are still waiting in the station's queue.
+func (s *StationOrderWorkflow) validatePriority(ctx workflow.Context, input *proto.StationOrderPriorityInput) error {
+	if !s.Status.Open {
+		return fmt.Errorf("order is closed")
+	}
+	if s.Status.Admitted {
+		return fmt.Errorf("order has already been let through the %s queue", s.Status.Station)
+	}
+
+	return nil
+}
+
+// handlePriority moves the order within the station's queue, which hears of it as the workflow
+// reports the change.
+func (s *StationOrderWorkflow) handlePriority(ctx workflow.Context, input *proto.StationOrderPriorityInput) (*proto.StationOrderStatus, error) {
+	s.Priority = input.Priority
+	s.changed.SendAsync(nil)
+
+	return s.Status, nil
+}
+
+// updateItem moves an item to a status which has been validated.
+func (s *StationOrderWorkflow) updateItem(ctx workflow.Context, input *proto.StationOrderItemStatusInput) {
+	s.Status.Items[input.Line-1].Status = input.Status
+
+	switch input.Status {
+	case proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_STARTED:
+		s.fulfilmentStarted = true
+	case proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_FAILED:
+		// A failed item is made again until it runs out of remakes. After that it is reported
+		// to the order for refund, the rest of the order carries on.
+		if !s.remakeItem(input.Line, input.Reason) {
+			workflow.GetLogger(ctx).Warn("item failed with no remakes left", "line", input.Line, "reason", input.Reason)
+		}
+		s.fulfilmentStarted = true
+		s.Status.Open = !s.isOrderCompleted()
+	case proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED:
+		s.fulfilmentStarted = true
+		s.Status.Open = !s.isOrderCompleted()
+	}
+}
+
+// remakeItem replaces a failed item with a new line for the station to make, unless it has already
+// been remade MaxRemakes times. It reports whether the item is being remade.
+func (s *StationOrderWorkflow) remakeItem(line uint32, reason string) bool {
+	failed := s.Status.Items[line-1]
+	if failed.Remakes >= s.MaxRemakes {
+		return false
+	}
+
+	failed.Status = proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_REMADE
+	s.Status.Items = append(s.Status.Items, &proto.StationOrderLineItem{
+		Name:         failed.Name,
+		Modifiers:    failed.Modifiers,
+		RemakeOf:     line,
+		RemakeReason: reason,
+		Remakes:      failed.Remakes + 1,
+	})
+
+	return true
+}
+
+func (s *StationOrderWorkflow) addItems(items []*proto.OrderLineItem) {
+	for _, li := range items {
+		for i := uint32(0); i < li.Count; i++ {
+			s.Status.Items = append(s.Status.Items, &proto.StationOrderLineItem{Name: li.Name, Modifiers: modifierNames(li.Modifiers)})
+		}
+	}
+}
+
+// removeItems cancels pending items, most recently added first. Line numbers are left unchanged.
+func (s *StationOrderWorkflow) removeItems(items []*proto.OrderLineItem) {
+	for _, li := range items {
+		count := li.Count
+		modifiers := modifierNames(li.Modifiers)
+		for i := len(s.Status.Items) - 1; i >= 0 && count > 0; i-- {
+			item := s.Status.Items[i]
+			if item.Name == li.Name && sameModifiers(item.Modifiers, modifiers) && item.Status == proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_PENDING {
+				item.Status = proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_CANCELLED
+				count--
+			}
+		}
+	}
+}
+
+func (s *StationOrderWorkflow) isOrderCompleted() bool {
+	for _, v := range s.Status.Items {
+		switch v.Status {
+		case proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_FAILED,
+			proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_CANCELLED, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_REMADE:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func (s *StationOrderWorkflow) signalFulfilmentStarted(ctx workflow.Context) error {
+	we := workflow.GetInfo(ctx).ParentWorkflowExecution
+	if we == nil {
+		return nil
+	}
+	signal := workflow.SignalExternalWorkflow(ctx, we.ID, we.RunID, proto.OrderFulfilmentStartedSignal, nil)
+	return signal.Get(ctx, nil)
+}
+
+func (s *StationOrderWorkflow) signalStatus(ctx workflow.Context) error {
+	we := workflow.GetInfo(ctx).ParentWorkflowExecution
+	if we == nil {
+		return nil
+	}
+	signal := workflow.SignalExternalWorkflow(ctx, we.ID, we.RunID, proto.OrderStationStatusSignal, s.Status)
+	return signal.Get(ctx, nil)
+}
@@ -0,0 +1,478 @@
+package workflows_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/temporalio/temporal-cafe/proto"
+	"github.com/temporalio/temporal-cafe/workflows"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestStationWorkflow(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.StationOrder)
+	admitStationOrders(env)
+
+	input := &proto.StationOrderInput{
+		Station: workflows.StationBarista,
+		Items: []*proto.OrderLineItem{
+			{Name: "coffee", Count: 1},
+			{Name: "latte", Count: 2},
+		},
+	}
+
+	env.RegisterDelayedCallback(func() {
+		updateItemStatus(t, env, &proto.StationOrderItemStatusInput{Line: 1, Status: proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED})
+
+		updateItemStatus(t, env, &proto.StationOrderItemStatusInput{Line: 2, Status: proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED})
+
+		updateItemStatus(t, env, &proto.StationOrderItemStatusInput{Line: 3, Status: proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED})
+	}, time.Second)
+
+	env.ExecuteWorkflow(workflows.StationOrder, input)
+	assert.True(t, env.IsWorkflowCompleted())
+
+	var result proto.StationOrderResult
+
+	err := env.GetWorkflowResult(&result)
+	assert.NoError(t, err)
+}
+
+func TestStationWorkflowItemFailed(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.StationOrder)
+	admitStationOrders(env)
+
+	input := &proto.StationOrderInput{
+		Station: workflows.StationBarista,
+		Items: []*proto.OrderLineItem{
+			{Name: "coffee", Count: 1},
+			{Name: "latte", Count: 1},
+		},
+	}
+
+	env.RegisterDelayedCallback(func() {
+		updateItemStatus(t, env, &proto.StationOrderItemStatusInput{Line: 1, Status: proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_FAILED})
+	}, time.Second)
+
+	env.RegisterDelayedCallback(func() {
+		v, err := env.QueryWorkflow(proto.StationOrderStatusQuery)
+		assert.NoError(t, err)
+		var status proto.StationOrderStatus
+		assert.NoError(t, v.Get(&status))
+
+		// The rest of the order is still being made.
+		assert.True(t, status.Open)
+
+		updateItemStatus(t, env, &proto.StationOrderItemStatusInput{Line: 2, Status: proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED})
+	}, 2*time.Second)
+
+	env.ExecuteWorkflow(workflows.StationOrder, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+
+	v, err := env.QueryWorkflow(proto.StationOrderStatusQuery)
+	assert.NoError(t, err)
+	var status proto.StationOrderStatus
+	assert.NoError(t, v.Get(&status))
+
+	assert.Equal(t, workflows.StationBarista, status.Station)
+	assert.False(t, status.Open)
+	assert.Equal(t, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_FAILED, status.Items[0].Status)
+}
+
+func TestStationWorkflowItems(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.StationOrder)
+	admitStationOrders(env)
+
+	input := &proto.StationOrderInput{
+		Station: workflows.StationBarista,
+		Items: []*proto.OrderLineItem{
+			{Name: "coffee", Count: 2},
+		},
+	}
+
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(
+			proto.StationOrderItemsSignal,
+			proto.StationOrderItemsUpdate{
+				Add:    []*proto.OrderLineItem{{Name: "latte", Count: 1}},
+				Remove: []*proto.OrderLineItem{{Name: "coffee", Count: 1}},
+			},
+		)
+
+		updateItemStatus(t, env, &proto.StationOrderItemStatusInput{Line: 1, Status: proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED})
+	}, time.Second)
+
+	// The added line can be made once the signal adding it has been handled.
+	env.RegisterDelayedCallback(func() {
+		updateItemStatus(t, env, &proto.StationOrderItemStatusInput{Line: 3, Status: proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED})
+	}, 2*time.Second)
+
+	env.ExecuteWorkflow(workflows.StationOrder, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+
+	v, err := env.QueryWorkflow(proto.StationOrderStatusQuery)
+	assert.NoError(t, err)
+	var status proto.StationOrderStatus
+	err = v.Get(&status)
+	assert.NoError(t, err)
+
+	assert.False(t, status.Open)
+	assert.Equal(t, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_CANCELLED, status.Items[1].Status)
+	assert.Equal(t, "latte", status.Items[2].Name)
+}
+
+func TestStationWorkflowModifiers(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.StationOrder)
+	admitStationOrders(env)
+
+	oat := []*proto.OrderLineItemModifier{{Group: "Milk", Name: "Oat", PriceDelta: 60}}
+
+	input := &proto.StationOrderInput{
+		Station: workflows.StationBarista,
+		Items: []*proto.OrderLineItem{
+			{Name: "coffee", Count: 1, Modifiers: oat},
+			{Name: "coffee", Count: 1},
+		},
+	}
+
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(
+			proto.StationOrderItemsSignal,
+			proto.StationOrderItemsUpdate{
+				Remove: []*proto.OrderLineItem{{Name: "coffee", Count: 1, Modifiers: oat}},
+			},
+		)
+
+		updateItemStatus(t, env, &proto.StationOrderItemStatusInput{Line: 2, Status: proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED})
+	}, time.Second)
+
+	env.ExecuteWorkflow(workflows.StationOrder, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+
+	v, err := env.QueryWorkflow(proto.StationOrderStatusQuery)
+	assert.NoError(t, err)
+	var status proto.StationOrderStatus
+	err = v.Get(&status)
+	assert.NoError(t, err)
+
+	assert.False(t, status.Open)
+	assert.Equal(t, []string{"Oat"}, status.Items[0].Modifiers)
+	assert.Equal(t, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_CANCELLED, status.Items[0].Status)
+	assert.Empty(t, status.Items[1].Modifiers)
+	assert.Equal(t, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED, status.Items[1].Status)
+}
+
+func TestStationWorkflowKitchen(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.StationOrder)
+	admitStationOrders(env)
+
+	input := &proto.StationOrderInput{
+		Station: workflows.StationKitchen,
+		Items: []*proto.OrderLineItem{
+			{Name: "bagel", Count: 1},
+			{Name: "muffin", Count: 1},
+		},
+	}
+
+	env.RegisterDelayedCallback(func() {
+		updateItemStatus(t, env, &proto.StationOrderItemStatusInput{Line: 1, Status: proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED})
+
+		updateItemStatus(t, env, &proto.StationOrderItemStatusInput{Line: 2, Status: proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED})
+	}, time.Second)
+
+	env.ExecuteWorkflow(workflows.StationOrder, input)
+	assert.True(t, env.IsWorkflowCompleted())
+
+	var result proto.StationOrderResult
+
+	err := env.GetWorkflowResult(&result)
+	assert.NoError(t, err)
+}
+
+func TestStationWorkflowKitchenItems(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.StationOrder)
+	admitStationOrders(env)
+
+	input := &proto.StationOrderInput{
+		Station: workflows.StationKitchen,
+		Items: []*proto.OrderLineItem{
+			{Name: "bagel", Count: 2},
+		},
+	}
+
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(
+			proto.StationOrderItemsSignal,
+			proto.StationOrderItemsUpdate{
+				Add:    []*proto.OrderLineItem{{Name: "muffin", Count: 1}},
+				Remove: []*proto.OrderLineItem{{Name: "bagel", Count: 1}},
+			},
+		)
+
+		updateItemStatus(t, env, &proto.StationOrderItemStatusInput{Line: 1, Status: proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED})
+	}, time.Second)
+
+	env.RegisterDelayedCallback(func() {
+		updateItemStatus(t, env, &proto.StationOrderItemStatusInput{Line: 3, Status: proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED})
+	}, 2*time.Second)
+
+	env.ExecuteWorkflow(workflows.StationOrder, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+
+	v, err := env.QueryWorkflow(proto.StationOrderStatusQuery)
+	assert.NoError(t, err)
+	var status proto.StationOrderStatus
+	err = v.Get(&status)
+	assert.NoError(t, err)
+
+	assert.False(t, status.Open)
+	assert.Equal(t, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_CANCELLED, status.Items[1].Status)
+	assert.Equal(t, "muffin", status.Items[2].Name)
+}
+
+func TestStationFor(t *testing.T) {
+	routing := &proto.StationRouting{
+		Routes: []*proto.StationRoute{
+			{Station: "bakery", Items: []string{"bagel"}},
+			{Station: "bar", Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Category: "Cold Drinks"},
+			{Station: workflows.StationKitchen, Type: proto.ProductType_PRODUCT_TYPE_FOOD},
+			{Station: workflows.StationBarista, Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE},
+		},
+	}
+	assert.NoError(t, workflows.ValidateStationRouting(routing))
+
+	assert.Equal(t, "bakery", workflows.StationFor(routing, proto.ProductType_PRODUCT_TYPE_FOOD, "Breakfast", "bagel"))
+	assert.Equal(t, workflows.StationKitchen, workflows.StationFor(routing, proto.ProductType_PRODUCT_TYPE_FOOD, "Breakfast", "muffin"))
+	assert.Equal(t, "bar", workflows.StationFor(routing, proto.ProductType_PRODUCT_TYPE_BEVERAGE, "Cold Drinks", "iced latte"))
+	assert.Equal(t, workflows.StationBarista, workflows.StationFor(routing, proto.ProductType_PRODUCT_TYPE_BEVERAGE, "Coffee", "latte"))
+	assert.Equal(t, "", workflows.StationFor(routing, proto.ProductType_PRODUCT_TYPE_UNKNOWN, "", "bagel"))
+
+	assert.Equal(t, []string{"bakery", "bar", workflows.StationBarista, workflows.StationKitchen}, workflows.Stations(routing))
+}
+
+func TestValidateStationRouting(t *testing.T) {
+	assert.NoError(t, workflows.ValidateStationRouting(workflows.DefaultStationRouting()))
+
+	// Cold drinks have a station, but other beverages don't.
+	err := workflows.ValidateStationRouting(&proto.StationRouting{
+		Routes: []*proto.StationRoute{
+			{Station: "bar", Type: proto.ProductType_PRODUCT_TYPE_BEVERAGE, Category: "Cold Drinks"},
+			{Station: workflows.StationKitchen, Type: proto.ProductType_PRODUCT_TYPE_FOOD},
+		},
+	})
+	assert.ErrorContains(t, err, "PRODUCT_TYPE_BEVERAGE")
+
+	err = workflows.ValidateStationRouting(&proto.StationRouting{
+		Routes: []*proto.StationRoute{
+			{Station: "Front Counter"},
+		},
+	})
+	assert.ErrorContains(t, err, "invalid station name")
+
+	// A route without a type covers every type.
+	assert.NoError(t, workflows.ValidateStationRouting(&proto.StationRouting{
+		Routes: []*proto.StationRoute{
+			{Station: "counter"},
+		},
+	}))
+
+	err = workflows.ValidateStationRouting(&proto.StationRouting{
+		Routes: []*proto.StationRoute{
+			{Station: "counter"},
+		},
+		Stations: []*proto.StationOptions{
+			{Station: workflows.StationBarista, Capacity: 2},
+		},
+	})
+	assert.ErrorContains(t, err, "unrouted station")
+}
+
+func TestStationWorkflowRemake(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.StationOrder)
+	admitStationOrders(env)
+
+	input := &proto.StationOrderInput{
+		Station:    workflows.StationBarista,
+		MaxRemakes: 2,
+		Items: []*proto.OrderLineItem{
+			{Name: "latte", Count: 1},
+		},
+	}
+
+	env.RegisterDelayedCallback(func() {
+		updateItemStatus(t, env, &proto.StationOrderItemStatusInput{Line: 1, Status: proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_FAILED, Reason: "spilled"})
+	}, time.Second)
+
+	env.RegisterDelayedCallback(func() {
+		v, err := env.QueryWorkflow(proto.StationOrderStatusQuery)
+		assert.NoError(t, err)
+		var status proto.StationOrderStatus
+		assert.NoError(t, v.Get(&status))
+
+		assert.True(t, status.Open)
+		assert.Equal(t, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_REMADE, status.Items[0].Status)
+		assert.Equal(t, "latte", status.Items[1].Name)
+		assert.Equal(t, uint32(1), status.Items[1].RemakeOf)
+		assert.Equal(t, "spilled", status.Items[1].RemakeReason)
+		assert.Equal(t, uint32(1), status.Items[1].Remakes)
+
+		// The remade line is finished with.
+		env.UpdateWorkflow(proto.StationOrderItemStatusUpdate, "remade", &updateCallback{
+			reject: func(err error) {
+				assert.ErrorContains(t, err, "cannot go from remade to completed")
+			},
+			complete: func(interface{}, error) {
+				assert.Fail(t, "update to remade line accepted")
+			},
+		}, &proto.StationOrderItemStatusInput{Line: 1, Status: proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED})
+		updateItemStatus(t, env, &proto.StationOrderItemStatusInput{Line: 2, Status: proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED})
+	}, 2*time.Second)
+
+	env.ExecuteWorkflow(workflows.StationOrder, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+
+	v, err := env.QueryWorkflow(proto.StationOrderStatusQuery)
+	assert.NoError(t, err)
+	var status proto.StationOrderStatus
+	assert.NoError(t, v.Get(&status))
+
+	assert.False(t, status.Open)
+	assert.Equal(t, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_REMADE, status.Items[0].Status)
+	assert.Equal(t, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED, status.Items[1].Status)
+}
+
+func TestStationWorkflowRemakesExhausted(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.StationOrder)
+	admitStationOrders(env)
+
+	input := &proto.StationOrderInput{
+		Station:    workflows.StationKitchen,
+		MaxRemakes: 1,
+		Items: []*proto.OrderLineItem{
+			{Name: "bagel", Count: 1},
+		},
+	}
+
+	env.RegisterDelayedCallback(func() {
+		updateItemStatus(t, env, &proto.StationOrderItemStatusInput{Line: 1, Status: proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_FAILED, Reason: "burnt"})
+		updateItemStatus(t, env, &proto.StationOrderItemStatusInput{Line: 2, Status: proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_FAILED, Reason: "burnt again"})
+	}, time.Second)
+
+	env.ExecuteWorkflow(workflows.StationOrder, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+
+	v, err := env.QueryWorkflow(proto.StationOrderStatusQuery)
+	assert.NoError(t, err)
+	var status proto.StationOrderStatus
+	assert.NoError(t, v.Get(&status))
+
+	// The remake failed too, so it is left failed for the order to refund.
+	assert.False(t, status.Open)
+	assert.Len(t, status.Items, 2)
+	assert.Equal(t, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_REMADE, status.Items[0].Status)
+	assert.Equal(t, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_FAILED, status.Items[1].Status)
+}
+
+func TestStationWorkflowItemStatusUpdate(t *testing.T) {
+	s := testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflow(workflows.StationOrder)
+	admitStationOrders(env)
+
+	input := &proto.StationOrderInput{
+		Station: workflows.StationBarista,
+		Items: []*proto.OrderLineItem{
+			{Name: "coffee", Count: 1},
+			{Name: "latte", Count: 1},
+		},
+	}
+
+	var updates int
+	update := func(line uint32, status proto.StationOrderItemStatus, check func(*proto.StationOrderStatus, error)) {
+		updates++
+		env.UpdateWorkflow(proto.StationOrderItemStatusUpdate, fmt.Sprintf("update-%d", updates), &updateCallback{
+			reject: func(err error) {
+				check(nil, err)
+			},
+			complete: func(result interface{}, err error) {
+				status, _ := result.(*proto.StationOrderStatus)
+				check(status, err)
+			},
+		}, &proto.StationOrderItemStatusInput{Line: line, Status: status})
+	}
+
+	env.RegisterDelayedCallback(func() {
+		update(1, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_STARTED, func(status *proto.StationOrderStatus, err error) {
+			assert.NoError(t, err)
+			assert.Equal(t, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_STARTED, status.Items[0].Status)
+		})
+		update(1, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED, func(status *proto.StationOrderStatus, err error) {
+			assert.NoError(t, err)
+			assert.True(t, status.Open)
+		})
+		update(1, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_PENDING, func(status *proto.StationOrderStatus, err error) {
+			assert.ErrorContains(t, err, "cannot go from completed to pending")
+		})
+		update(2, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_CANCELLED, func(status *proto.StationOrderStatus, err error) {
+			assert.ErrorContains(t, err, "cannot go from pending to cancelled")
+		})
+		update(3, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED, func(status *proto.StationOrderStatus, err error) {
+			assert.ErrorContains(t, err, "invalid line item: 3")
+			var rejected *temporal.ApplicationError
+			if assert.ErrorAs(t, err, &rejected) {
+				assert.Equal(t, workflows.StationOrderLineInvalidError, rejected.Type())
+			}
+		})
+
+		update(2, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED, func(status *proto.StationOrderStatus, err error) {
+			assert.NoError(t, err)
+			assert.False(t, status.Open)
+		})
+	}, time.Second)
+
+	env.ExecuteWorkflow(workflows.StationOrder, input)
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+
+	v, err := env.QueryWorkflow(proto.StationOrderStatusQuery)
+	assert.NoError(t, err)
+	var status proto.StationOrderStatus
+	assert.NoError(t, v.Get(&status))
+
+	assert.Equal(t, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED, status.Items[0].Status)
+	assert.Equal(t, proto.StationOrderItemStatus_STATION_ORDER_ITEM_STATUS_COMPLETED, status.Items[1].Status)
+}
@@ -0,0 +1,345 @@
+package workflows
+
+import (
+	"fmt"
+
+	"github.com/temporalio/temporal-cafe/proto"
+	"go.temporal.io/sdk/workflow"
+)
+
+// orderPayment is an authorization against a card.
+type orderPayment struct {
+	payment  *proto.Payment
+	amount   uint32
+	captured uint32
+	refunded uint32
+	voided   bool
+}
+
+// orderTender is one of the ways an order is paid for, along with what has been taken from it.
+type orderTender struct {
+	*proto.Tender
+	Status *proto.OrderTenderStatus
+
+	payments []*orderPayment
+	// authorizations counts the card authorizations requested from the tender, including declined
+	// ones, so that each has its own reference.
+	authorizations int
+}
+
+// held is the amount currently taken from the tender.
+func (t *orderTender) held() uint32 {
+	return t.Status.Amount - t.Status.Refunded
+}
+
+// unit is the smallest amount which can be given back to the tender.
+func (t *orderTender) unit() uint32 {
+	if t.Type == proto.TenderType_TENDER_TYPE_LOYALTY_POINTS {
+		return CustomerLoyaltyPointValue
+	}
+
+	return 1
+}
+
+// orderTenders returns the tenders for an order. Orders without tenders are paid from their gift
+// card, if they have one, and then their payment token.
+func orderTenders(input *proto.OrderInput) []*proto.Tender {
+	if len(input.Tenders) > 0 {
+		return input.Tenders
+	}
+
+	var tenders []*proto.Tender
+	if input.GiftCard != "" {
+		tenders = append(tenders, &proto.Tender{Type: proto.TenderType_TENDER_TYPE_GIFT_CARD, Token: input.GiftCard})
+	}
+	tenders = append(tenders, &proto.Tender{Type: proto.TenderType_TENDER_TYPE_CARD, Token: input.PaymentToken})
+
+	return tenders
+}
+
+func (o *OrderWorkflow) addTender(tender *proto.Tender) {
+	t := &orderTender{Tender: tender, Status: &proto.OrderTenderStatus{Type: tender.Type}}
+
+	o.tenders = append(o.tenders, t)
+	o.Status.Tenders = append(o.Status.Tenders, t.Status)
+}
+
+// held is the amount currently taken from all the tenders.
+func (o *OrderWorkflow) held() uint32 {
+	var total uint32
+	for _, t := range o.tenders {
+		total += t.held()
+	}
+
+	return total
+}
+
+// cardTender returns the last card tender, which pays for any items added to the order.
+func (o *OrderWorkflow) cardTender() *orderTender {
+	for i := len(o.tenders) - 1; i >= 0; i-- {
+		if o.tenders[i].Type == proto.TenderType_TENDER_TYPE_CARD {
+			return o.tenders[i]
+		}
+	}
+
+	return nil
+}
+
+// pay takes the order total from the tenders in turn. This is a saga: if a tender can't be
+// charged, everything already taken from earlier tenders is given back.
+func (o *OrderWorkflow) pay(ctx workflow.Context) error {
+	due := o.Status.Total
+	for i, t := range o.tenders {
+		amount := t.Amount
+		if amount == 0 || amount > due {
+			amount = due
+		}
+		if amount == 0 {
+			continue
+		}
+
+		taken, err := o.take(ctx, i, amount)
+		if err != nil {
+			_ = o.releaseAll(ctx)
+			return err
+		}
+		due -= taken
+	}
+
+	if due > 0 {
+		_ = o.releaseAll(ctx)
+		return ErrPaymentIncomplete
+	}
+
+	return nil
+}
+
+// take charges up to amount to a tender, returning how much was taken. Gift cards and loyalty points
+// are limited to their balance, and loyalty points can only pay in whole points.
+func (o *OrderWorkflow) take(ctx workflow.Context, i int, amount uint32) (uint32, error) {
+	t := o.tenders[i]
+
+	switch t.Type {
+	case proto.TenderType_TENDER_TYPE_GIFT_CARD:
+		r, err := redeemGiftCard(ctx, t.Token, o.tenderReference(ctx, i), amount)
+		if err != nil {
+			return 0, err
+		}
+		t.Status.Amount += r.Amount
+		return r.Amount, nil
+	case proto.TenderType_TENDER_TYPE_LOYALTY_POINTS:
+		points := amount / CustomerLoyaltyPointValue
+		if points == 0 {
+			return 0, nil
+		}
+		r, err := redeemLoyaltyPoints(ctx, t.Token, workflow.GetInfo(ctx).WorkflowExecution.ID, o.tenderReference(ctx, i), points)
+		if err != nil {
+			return 0, err
+		}
+		t.Status.Amount += r.Points * CustomerLoyaltyPointValue
+		return r.Points * CustomerLoyaltyPointValue, nil
+	case proto.TenderType_TENDER_TYPE_CARD:
+		if _, err := o.authorize(ctx, t, amount); err != nil {
+			return 0, err
+		}
+		return amount, nil
+	}
+
+	return 0, fmt.Errorf("unknown tender type: %s", t.Type)
+}
+
+// tenderReference identifies the redemption made for a gift card or loyalty points tender, and
+// prefixes the references of a card tender's authorizations.
+func (o *OrderWorkflow) tenderReference(ctx workflow.Context, i int) string {
+	return fmt.Sprintf("%s/%d", workflow.GetInfo(ctx).WorkflowExecution.ID, i+1)
+}
+
+// giveBack returns amount to a gift card or loyalty points tender. Loyalty points are returned as
+// whole points, so amount should be a multiple of their value.
+func (o *OrderWorkflow) giveBack(ctx workflow.Context, i int, amount uint32) error {
+	t := o.tenders[i]
+	if amount == 0 {
+		return nil
+	}
+
+	var err error
+	switch t.Type {
+	case proto.TenderType_TENDER_TYPE_GIFT_CARD:
+		err = refundGiftCard(ctx, t.Token, o.tenderReference(ctx, i), amount)
+	case proto.TenderType_TENDER_TYPE_LOYALTY_POINTS:
+		// A forgotten customer no longer has an account to give points back to.
+		if o.Status.CustomerForgotten {
+			break
+		}
+		err = releaseLoyaltyPoints(ctx, t.Token, o.tenderReference(ctx, i), amount/CustomerLoyaltyPointValue)
+	}
+	if err != nil {
+		return err
+	}
+	t.Status.Refunded += amount
+
+	return nil
+}
+
+// releaseAll gives back everything held on the tenders, most recent first.
+func (o *OrderWorkflow) releaseAll(ctx workflow.Context) error {
+	for i := len(o.tenders) - 1; i >= 0; i-- {
+		t := o.tenders[i]
+
+		switch t.Type {
+		case proto.TenderType_TENDER_TYPE_GIFT_CARD, proto.TenderType_TENDER_TYPE_LOYALTY_POINTS:
+			if err := o.giveBack(ctx, i, t.held()); err != nil {
+				return err
+			}
+		case proto.TenderType_TENDER_TYPE_CARD:
+			for _, p := range t.payments {
+				switch {
+				case p.voided:
+				case p.captured > 0:
+					// Settling failed after the payment was captured, so the customer has been charged.
+					amount := p.captured - p.refunded
+					if err := o.refund(ctx, p, amount); err != nil {
+						return err
+					}
+					t.Status.Refunded += amount
+				default:
+					if err := o.void(ctx, p); err != nil {
+						return err
+					}
+					t.Status.Refunded += p.amount
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// settle takes payment for the items which were made. Anything held which is no longer owed, because
+// items were removed or failed, is apportioned back across the tenders in proportion to what was
+// taken from each. Loyalty points are only given back in whole points.
+func (o *OrderWorkflow) settle(ctx workflow.Context) error {
+	held := o.held()
+	excess := held - (o.Status.Total - o.Status.Refunded)
+
+	shares := make([]uint32, len(o.tenders))
+	var apportioned uint32
+	for i, t := range o.tenders {
+		if held > 0 {
+			shares[i] = uint32(uint64(excess) * uint64(t.held()) / uint64(held))
+		}
+		shares[i] -= shares[i] % t.unit()
+		apportioned += shares[i]
+	}
+	// Rounding leaves a little over, which goes to the most recent tenders with room for it.
+	for i := len(o.tenders) - 1; i >= 0 && apportioned < excess; i-- {
+		t := o.tenders[i]
+		room := t.held() - shares[i]
+		if room > excess-apportioned {
+			room = excess - apportioned
+		}
+		room -= room % t.unit()
+		shares[i] += room
+		apportioned += room
+	}
+	// Anything still over is less than a loyalty point, which the customer gets back in full.
+	for i := len(o.tenders) - 1; i >= 0 && apportioned < excess; i-- {
+		t := o.tenders[i]
+		if t.unit() > 1 && t.held()-shares[i] >= t.unit() {
+			shares[i] += t.unit()
+			apportioned += t.unit()
+		}
+	}
+
+	for i, t := range o.tenders {
+		switch t.Type {
+		case proto.TenderType_TENDER_TYPE_GIFT_CARD, proto.TenderType_TENDER_TYPE_LOYALTY_POINTS:
+			if err := o.giveBack(ctx, i, shares[i]); err != nil {
+				return err
+			}
+		case proto.TenderType_TENDER_TYPE_CARD:
+			if err := o.capture(ctx, t, t.held()-shares[i]); err != nil {
+				return err
+			}
+			t.Status.Refunded += shares[i]
+		}
+	}
+
+	return nil
+}
+
+// authorize places a hold of amount on a card tender. Each authorization has its own reference, so
+// that the gateway doesn't place a second hold when the request is retried.
+func (o *OrderWorkflow) authorize(ctx workflow.Context, t *orderTender, amount uint32) (*orderPayment, error) {
+	var i int
+	for i = range o.tenders {
+		if o.tenders[i] == t {
+			break
+		}
+	}
+	t.authorizations++
+	reference := fmt.Sprintf("%s/%d", o.tenderReference(ctx, i), t.authorizations)
+
+	r, err := authorizePayment(ctx, reference, t.Token, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &orderPayment{payment: r.Payment, amount: amount}
+	t.payments = append(t.payments, p)
+	t.Status.Amount += amount
+
+	return p, nil
+}
+
+// capture takes amount from the tender's authorizations, oldest first. Authorizations which are no
+// longer needed are voided.
+func (o *OrderWorkflow) capture(ctx workflow.Context, t *orderTender, amount uint32) error {
+	remaining := amount
+	for _, p := range t.payments {
+		if p.voided || p.captured > 0 {
+			continue
+		}
+
+		c := p.amount
+		if c > remaining {
+			c = remaining
+		}
+		if c == 0 {
+			if err := o.void(ctx, p); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := capturePayment(ctx, p.payment, c); err != nil {
+			return err
+		}
+		p.captured = c
+		remaining -= c
+	}
+
+	return nil
+}
+
+func (o *OrderWorkflow) void(ctx workflow.Context, p *orderPayment) error {
+	if err := voidPayment(ctx, p.payment); err != nil {
+		return err
+	}
+	p.voided = true
+
+	return nil
+}
+
+// refund returns amount from a captured payment.
+func (o *OrderWorkflow) refund(ctx workflow.Context, p *orderPayment, amount uint32) error {
+	if amount == 0 {
+		return nil
+	}
+	if err := processPaymentRefund(ctx, p.payment, amount); err != nil {
+		return err
+	}
+	p.refunded += amount
+
+	return nil
+}
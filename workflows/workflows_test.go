@@ -0,0 +1,113 @@
+package workflows_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	act "github.com/temporalio/temporal-cafe/activities"
+	"github.com/temporalio/temporal-cafe/proto"
+	"github.com/temporalio/temporal-cafe/workflows"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+type updateCallback struct {
+	accept   func()
+	reject   func(error)
+	complete func(interface{}, error)
+}
+
+func (uc *updateCallback) Accept() {
+	if uc.accept != nil {
+		uc.accept()
+	}
+}
+
+func (uc *updateCallback) Reject(err error) {
+	if uc.reject != nil {
+		uc.reject(err)
+	}
+}
+
+func (uc *updateCallback) Complete(success interface{}, err error) {
+	if uc.complete != nil {
+		uc.complete(success, err)
+	}
+}
+
+// childStation returns the station a child station order workflow was started for.
+func childStation(args converter.EncodedValues) string {
+	var input proto.StationOrderInput
+	if err := args.Get(&input); err != nil {
+		return ""
+	}
+
+	return input.Station
+}
+
+// updateItemStatus changes the status of an item in the station order under test, as station staff
+// do. The change is expected to be accepted.
+func updateItemStatus(t *testing.T, env *testsuite.TestWorkflowEnvironment, input *proto.StationOrderItemStatusInput) {
+	id := fmt.Sprintf("%d-%s", input.Line, input.Status)
+	env.UpdateWorkflow(proto.StationOrderItemStatusUpdate, id, &updateCallback{
+		reject: func(err error) {
+			assert.Fail(t, "unexpected rejection", err)
+		},
+	}, input)
+}
+
+// registerStationOrders registers the station orders started by orders under test, which are
+// driven with setItemStatus.
+func registerStationOrders(env *testsuite.TestWorkflowEnvironment) {
+	env.RegisterWorkflowWithOptions(workflows.StationOrderWithItemSignals, workflow.RegisterOptions{Name: "StationOrder"})
+}
+
+// setItemStatus changes the status of an item in a station order started by an order, as station
+// staff do.
+func setItemStatus(env *testsuite.TestWorkflowEnvironment, workflowID string, line uint32, status proto.StationOrderItemStatus) {
+	_ = env.SignalWorkflowByID(workflowID, workflows.StationOrderItemStatusTestSignal, &proto.StationOrderItemStatusInput{Line: line, Status: status})
+}
+
+// admitStationOrders stands in for the station queues, letting each station order through as soon
+// as it joins its queue.
+func admitStationOrders(env *testsuite.TestWorkflowEnvironment) {
+	env.RegisterActivity(activities.QueueStationOrder)
+	env.OnActivity(activities.QueueStationOrder, mock.Anything, mock.Anything).Return(func(ctx context.Context, input *proto.QueueStationOrderInput) (*proto.QueueStationOrderResult, error) {
+		if input.Order.Open {
+			_ = env.SignalWorkflowByID(input.Order.OrderId, proto.StationOrderAdmittedSignal, nil)
+		}
+		return &proto.QueueStationOrderResult{}, nil
+	})
+}
+
+// registerPayments registers the payment activities against a simulator of their own, which lets
+// every payment through. Every test workflow has the same ID, so a simulator shared between tests
+// would see the same authorization references more than once.
+func registerPayments(env *testsuite.TestWorkflowEnvironment) {
+	gateway := &act.Activities{Payments: act.NewPaymentSimulator(act.PaymentSimulatorOptions{})}
+	env.RegisterActivity(gateway.AuthorizePayment)
+	env.RegisterActivity(gateway.CapturePayment)
+	env.RegisterActivity(gateway.VoidPayment)
+	env.RegisterActivity(gateway.ProcessPaymentRefund)
+}
+
+// orderActivityCalls records the activities run by order workflows, returning a func which lists
+// them in the order they started. Station orders run their own activities to join the station
+// queue, which are left out.
+func orderActivityCalls(env *testsuite.TestWorkflowEnvironment) func() []string {
+	var calls []string
+	env.SetOnActivityStartedListener(func(activityInfo *activity.Info, ctx context.Context, args converter.EncodedValues) {
+		if activityInfo.WorkflowType.Name == "Order" {
+			calls = append(calls, activityInfo.ActivityType.Name)
+		}
+	})
+
+	return func() []string {
+		return calls
+	}
+}